@@ -0,0 +1,332 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbBiComplex = [4]string{"", "i", "j", "k"}
+
+// A BiComplex represents an integral bicomplex number, also known as a
+// tessarine. It is built from two Complex halves as a + bj, where j commutes
+// with i and Mul(j, j) = +1.
+type BiComplex struct {
+	l, r Complex
+}
+
+// Real returns the (integral) real part of z.
+func (z *BiComplex) Real() *big.Int {
+	return (&z.l).Real()
+}
+
+// Cartesian returns the four integral Cartesian components of z.
+func (z *BiComplex) Cartesian() (*big.Int, *big.Int, *big.Int, *big.Int) {
+	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
+}
+
+// Components returns the four Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *BiComplex) Components() []*big.Int {
+	a, b, c, d := z.Cartesian()
+	return []*big.Int{a, b, c, d}
+}
+
+// String returns the string representation of a BiComplex value.
+//
+// If z corresponds to a + bi + cj + dk, then the string is "(a+bi+cj+dk)",
+// similar to complex128 values.
+func (z *BiComplex) String() string {
+	return z.StringWith(symbBiComplex)
+}
+
+// StringWith returns the string representation of z with the units written as
+// symb, where symb[0] is the unit of the real part. The layout is the same as
+// that of String.
+func (z *BiComplex) StringWith(symb [4]string) string {
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	a := make([]string, 9)
+	a[0] = "("
+	a[1] = fmt.Sprintf("%v%s", v[0], symb[0])
+	i := 1
+	for j := 2; j < 8; j = j + 2 {
+		if v[i].Sign() < 0 {
+			a[j] = fmt.Sprintf("%v", v[i])
+		} else {
+			a[j] = fmt.Sprintf("+%v", v[i])
+		}
+		a[j+1] = symb[i]
+		i++
+	}
+	a[8] = ")"
+	return strings.Join(a, "")
+}
+
+// Compact returns a compact string representation of z. It is like String, but
+// zero terms are omitted and unit coefficients are collapsed, so that the
+// string is "(1)" or "(1+j)" instead of listing every component. The zero
+// value gives "(0)".
+func (z *BiComplex) Compact() string {
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return "(" + compactTerms(v, symbBiComplex[:]) + ")"
+}
+
+// Format implements the fmt.Formatter interface. The verbs 'b', 'o', 'O', 'd',
+// 'x', and 'X' format each component of z like a big.Int, with the flags,
+// width, and precision forwarded. The '+' flag forces a sign on the real part;
+// the other parts always have a sign. Any other verb gives the same result as
+// String.
+func (z *BiComplex) Format(s fmt.State, ch rune) {
+	switch ch {
+	case 'b', 'o', 'O', 'd', 'x', 'X':
+		v := make([]*big.Int, 4)
+		v[0], v[1], v[2], v[3] = z.Cartesian()
+		fmt.Fprint(s, formatTerms(s, ch, v, symbBiComplex[:]))
+	default:
+		fmt.Fprint(s, z.String())
+	}
+}
+
+// SetString sets z equal to the value of s, and returns z and a boolean
+// indicating success. The string s must be of the form "(a+bi+cj+dk)", where
+// omitted terms are zero. If SetString fails, the value of z is undefined but
+// the returned value is nil.
+func (z *BiComplex) SetString(s string) (*BiComplex, bool) {
+	v, ok := parseTerms(s, symbBiComplex[:])
+	if !ok {
+		return nil, false
+	}
+	z.l.l.Set(v[0])
+	z.l.r.Set(v[1])
+	z.r.l.Set(v[2])
+	z.r.r.Set(v[3])
+	return z, true
+}
+
+// Equals returns true if y and z are equal.
+func (z *BiComplex) Equals(y *BiComplex) bool {
+	return z.l.Equals(&y.l) && z.r.Equals(&y.r)
+}
+
+// IsReal returns true if all the non-real components of z are zero.
+func (z *BiComplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Complex))
+}
+
+// IsUnreal returns true if the real part of z is zero and at least one of the
+// other components is not, so that z is a nonzero pure unreal value.
+func (z *BiComplex) IsUnreal() bool {
+	return z.l.l.Sign() == 0 && !z.IsReal()
+}
+
+// Set sets z equal to y, and returns z.
+func (z *BiComplex) Set(y *BiComplex) *BiComplex {
+	z.l.Set(&y.l)
+	z.r.Set(&y.r)
+	return z
+}
+
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *BiComplex) Clone() *BiComplex {
+	return new(BiComplex).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *BiComplex) IsZero() bool {
+	return z.l.IsZero() && z.r.IsZero()
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *BiComplex) SetZero() *BiComplex {
+	z.l.SetZero()
+	z.r.SetZero()
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *BiComplex) SetOne() *BiComplex {
+	z.l.SetOne()
+	z.r.SetZero()
+	return z
+}
+
+// NewBiComplex returns a pointer to the BiComplex value a+bi+cj+dk.
+func NewBiComplex(a, b, c, d *big.Int) *BiComplex {
+	z := new(BiComplex)
+	z.l.l.Set(a)
+	z.l.r.Set(b)
+	z.r.l.Set(c)
+	z.r.r.Set(d)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *BiComplex) Scal(y *BiComplex, a *big.Int) *BiComplex {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *BiComplex) Neg(y *BiComplex) *BiComplex {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the j-conjugate of y, and returns z. If y = a + bj,
+// where a and b are Complex values, then the j-conjugate is a - bj. Unlike the
+// conjugate of Hamilton, this leaves i fixed, so that it is an automorphism.
+func (z *BiComplex) Conj(y *BiComplex) *BiComplex {
+	z.l.Set(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *BiComplex) Add(x, y *BiComplex) *BiComplex {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *BiComplex) Sub(x, y *BiComplex) *BiComplex {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Sum sets z equal to the sum of xs, and returns z. If xs is empty, then z is
+// zero.
+func (z *BiComplex) Sum(xs ...*BiComplex) *BiComplex {
+	s := new(BiComplex)
+	for _, x := range xs {
+		s.Add(s, x)
+	}
+	return z.Set(s)
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// The multiplication rules are:
+// 		Mul(i, i) = Mul(k, k) = -1
+// 		Mul(j, j) = +1
+// 		Mul(i, j) = Mul(j, i) = +k
+// 		Mul(i, k) = Mul(k, i) = -j
+// 		Mul(j, k) = Mul(k, j) = +i
+// This binary operation is commutative and associative.
+func (z *BiComplex) Mul(x, y *BiComplex) *BiComplex {
+	a := new(Complex).Set(&x.l)
+	b := new(Complex).Set(&x.r)
+	c := new(Complex).Set(&y.l)
+	d := new(Complex).Set(&y.r)
+	temp := new(Complex)
+	z.l.Add(
+		z.l.Mul(a, c),
+		temp.Mul(b, d),
+	)
+	z.r.Add(
+		z.r.Mul(a, d),
+		temp.Mul(b, c),
+	)
+	return z
+}
+
+// Quad returns the quadrance of z. If z = a+bi+cj+dk, then the quadrance is
+// 		Mul(a, a) + Mul(b, b) + Mul(c, c) + Mul(d, d)
+// This is always non-negative, but it is not multiplicative. For the
+// multiplicative norm, see ComplexQuad.
+func (z *BiComplex) Quad() *big.Int {
+	return new(big.Int).Add(
+		z.l.Quad(),
+		z.r.Quad(),
+	)
+}
+
+// ComplexQuad returns the complex quadrance of z. If z = a + bj, where a and b
+// are Complex values, then the complex quadrance is
+// 		Mul(z, Conj(z)) = Mul(a, a) - Mul(b, b)
+// This is a Complex value rather than a big.Int, and it is multiplicative:
+// ComplexQuad(Mul(x, y)) = Mul(ComplexQuad(x), ComplexQuad(y)). It is zero
+// exactly when z is a zero divisor.
+func (z *BiComplex) ComplexQuad() *Complex {
+	quad := new(Complex).Mul(&z.l, &z.l)
+	return quad.Sub(quad, new(Complex).Mul(&z.r, &z.r))
+}
+
+// IsZeroDiv returns true if z is a zero divisor, that is, if one of the
+// coordinates given by Diagonal is zero.
+func (z *BiComplex) IsZeroDiv() bool {
+	p, q := z.Diagonal()
+	return p.IsZero() || q.IsZero()
+}
+
+// Diagonal returns the coordinates of z in the basis of the idempotents
+// 		e+ = (1+j)/2 and e- = (1-j)/2
+// If z = a + bj, where a and b are Complex values, then z = (a+b)e+ + (a-b)e-,
+// so the coordinates are a+b and a-b. This splits the bicomplex numbers as a
+// direct sum of two copies of the complex numbers: multiplication is
+// componentwise, so the coordinates of Mul(x, y) are the products of the
+// coordinates of x and y. Note that e+ and e- are not integral, so the two
+// coordinates are congruent modulo two.
+func (z *BiComplex) Diagonal() (*Complex, *Complex) {
+	return new(Complex).Add(&z.l, &z.r), new(Complex).Sub(&z.l, &z.r)
+}
+
+// FromDiagonal sets z equal to p e+ + q e-, the inverse of Diagonal, and
+// returns z and true. Since z = (p+q)/2 + ((p-q)/2)j, this is integral only if
+// both components of p+q are even. Otherwise z is not modified, and
+// FromDiagonal returns nil and false.
+func (z *BiComplex) FromDiagonal(p, q *Complex) (*BiComplex, bool) {
+	a := new(Complex).Add(p, q)
+	if a.l.Bit(0) != 0 || a.r.Bit(0) != 0 {
+		return nil, false
+	}
+	z.r.Sub(p, q)
+	z.r.l.Rsh(&z.r.l, 1)
+	z.r.r.Rsh(&z.r.r, 1)
+	z.l.l.Rsh(&a.l, 1)
+	z.l.r.Rsh(&a.r, 1)
+	return z, true
+}
+
+// Quo sets z equal to the quotient of x and y, and returns z. If y = a + bj,
+// where a and b are Complex values, then the quotient is
+// 		Mul(x, Conj(y))
+// with each Complex half divided by ComplexQuad(y) as in Quo for Complex. Note
+// that truncated division is used. If y is a zero divisor, then Quo panics.
+func (z *BiComplex) Quo(x, y *BiComplex) *BiComplex {
+	if y.IsZeroDiv() {
+		panic("zero denominator")
+	}
+	quad := y.ComplexQuad()
+	p := new(BiComplex).Conj(y)
+	p.Mul(x, p)
+	z.l.Quo(&p.l, quad)
+	z.r.Quo(&p.r, quad)
+	return z
+}
+
+// Generate returns a random BiComplex value for quick.Check testing.
+func (z *BiComplex) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomBiComplex := &BiComplex{
+		*NewComplex(
+			big.NewInt(rand.Int63()),
+			big.NewInt(rand.Int63()),
+		),
+		*NewComplex(
+			big.NewInt(rand.Int63()),
+			big.NewInt(rand.Int63()),
+		),
+	}
+	return reflect.ValueOf(randomBiComplex)
+}
@@ -0,0 +1,348 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// Commutativity
+
+func TestBiComplexAddCommutative(t *testing.T) {
+	f := func(x, y *BiComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(BiComplex).Add(x, y)
+		r := new(BiComplex).Add(y, x)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiComplexMulCommutative(t *testing.T) {
+	f := func(x, y *BiComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(BiComplex).Mul(x, y)
+		r := new(BiComplex).Mul(y, x)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Associativity
+
+func TestBiComplexMulAssociative(t *testing.T) {
+	f := func(x, y, z *BiComplex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l, r := new(BiComplex), new(BiComplex)
+		l.Mul(l.Mul(x, y), z)
+		r.Mul(x, r.Mul(y, z))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Identity
+
+func TestBiComplexMulOne(t *testing.T) {
+	f := func(x *BiComplex) bool {
+		// t.Logf("x = %v", x)
+		one := new(BiComplex).SetOne()
+		return new(BiComplex).Mul(x, one).Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Distributivity
+
+func TestBiComplexAddMulDistributive(t *testing.T) {
+	f := func(x, y, z *BiComplex) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l, r := new(BiComplex), new(BiComplex)
+		l.Mul(l.Add(x, y), z)
+		r.Add(r.Mul(x, z), new(BiComplex).Mul(y, z))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiComplexMulConjDistributive(t *testing.T) {
+	f := func(x, y *BiComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l, r := new(BiComplex), new(BiComplex)
+		l.Conj(l.Mul(x, y))
+		r.Mul(r.Conj(x), new(BiComplex).Conj(y))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Positivity
+
+func TestBiComplexQuadPositive(t *testing.T) {
+	f := func(x *BiComplex) bool {
+		// t.Logf("x = %v", x)
+		return x.Quad().Sign() > 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if quad := new(BiComplex).Quad(); quad.Sign() != 0 {
+		t.Errorf("Quad(0) = %v, want 0", quad)
+	}
+}
+
+// Composition
+
+func TestBiComplexComposition(t *testing.T) {
+	f := func(x, y *BiComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(BiComplex).Mul(x, y).ComplexQuad()
+		r := new(Complex).Mul(x.ComplexQuad(), y.ComplexQuad())
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiComplexMulTable(t *testing.T) {
+	b := func(a, b, c, d int64) *BiComplex {
+		return NewBiComplex(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	i, j, k := b(0, 1, 0, 0), b(0, 0, 1, 0), b(0, 0, 0, 1)
+	var tests = []struct {
+		x, y, want *BiComplex
+	}{
+		{i, i, b(-1, 0, 0, 0)},
+		{j, j, b(1, 0, 0, 0)},
+		{k, k, b(-1, 0, 0, 0)},
+		{i, j, k},
+		{j, i, k},
+		{i, k, b(0, 0, -1, 0)},
+		{j, k, i},
+	}
+	for _, test := range tests {
+		if got := new(BiComplex).Mul(test.x, test.y); !got.Equals(test.want) {
+			t.Errorf("Mul(%v, %v) = %v, want %v", test.x, test.y, got, test.want)
+		}
+	}
+}
+
+// Parsing
+
+func TestBiComplexStringSetString(t *testing.T) {
+	f := func(x *BiComplex) bool {
+		// t.Logf("x = %v", x)
+		y, ok := new(BiComplex).SetString(x.String())
+		return ok && y.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Formatting
+
+func TestBiComplexCompact(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	var tests = []struct {
+		z    *BiComplex
+		want string
+	}{
+		{new(BiComplex), "(0)"},
+		{NewBiComplex(big.NewInt(-5), zero, zero, zero), "(-5)"},
+		{NewBiComplex(one, zero, one, zero), "(1+j)"},
+		{NewBiComplex(zero, big.NewInt(2), zero, big.NewInt(-1)), "(2i-k)"},
+	}
+	for _, test := range tests {
+		if got := test.z.Compact(); got != test.want {
+			t.Errorf("Compact(%v) = %q, want %q", test.z, got, test.want)
+		}
+	}
+}
+
+func TestBiComplexFormat(t *testing.T) {
+	z := NewBiComplex(big.NewInt(255), big.NewInt(-16), big.NewInt(0), big.NewInt(10))
+	var tests = []struct {
+		format, want string
+	}{
+		{"%v", "(255-16i+0j+10k)"},
+		{"%+d", "(+255-16i+0j+10k)"},
+		{"%x", "(ff-10i+0j+ak)"},
+		{"%.3d", "(255-016i+000j+010k)"},
+	}
+	for _, test := range tests {
+		if got := fmt.Sprintf(test.format, z); got != test.want {
+			t.Errorf("Sprintf(%q, %v) = %q, want %q", test.format, z, got, test.want)
+		}
+	}
+}
+
+// Idempotent decomposition
+
+func TestBiComplexDiagonalMul(t *testing.T) {
+	f := func(x, y *BiComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p1, q1 := x.Diagonal()
+		p2, q2 := y.Diagonal()
+		p, q := new(BiComplex).Mul(x, y).Diagonal()
+		return p.Equals(p1.Mul(p1, p2)) && q.Equals(q1.Mul(q1, q2))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiComplexFromDiagonal(t *testing.T) {
+	f := func(x *BiComplex) bool {
+		// t.Logf("x = %v", x)
+		y, ok := new(BiComplex).FromDiagonal(x.Diagonal())
+		return ok && y.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	p := NewComplex(big.NewInt(1), big.NewInt(2))
+	q := NewComplex(big.NewInt(3), big.NewInt(3))
+	if y, ok := new(BiComplex).FromDiagonal(p, q); ok {
+		t.Errorf("FromDiagonal(%v, %v) = %v, true, want false", p, q, y)
+	}
+}
+
+// Zero divisors
+
+func TestBiComplexIsZeroDiv(t *testing.T) {
+	b := func(a, b, c, d int64) *BiComplex {
+		return NewBiComplex(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		x    *BiComplex
+		want bool
+	}{
+		{b(0, 0, 0, 0), true},
+		{b(1, 0, 1, 0), true},
+		{b(1, 0, -1, 0), true},
+		{b(0, 1, 0, 1), true},
+		{b(2, 3, -2, -3), true},
+		{b(1, 0, 0, 0), false},
+		{b(0, 0, 1, 0), false},
+		{b(0, 1, 1, 0), false},
+	}
+	for _, test := range tests {
+		if got := test.x.IsZeroDiv(); got != test.want {
+			t.Errorf("IsZeroDiv(%v) = %v, want %v", test.x, got, test.want)
+		}
+		if quad := test.x.ComplexQuad(); quad.IsZero() != test.want {
+			t.Errorf("ComplexQuad(%v) = %v, want zero = %v", test.x, quad, test.want)
+		}
+	}
+	x, y := b(1, 0, 1, 0), b(1, 0, -1, 0)
+	if p := new(BiComplex).Mul(x, y); !p.IsZero() {
+		t.Errorf("Mul(%v, %v) = %v, want 0", x, y, p)
+	}
+}
+
+// Sums
+
+func TestBiComplexSum(t *testing.T) {
+	f := func(x, y, w *BiComplex) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(BiComplex).Sum(x, y, w)
+		r := new(BiComplex).Add(new(BiComplex).Add(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if z := new(BiComplex).Sum(); !z.IsZero() {
+		t.Errorf("Sum() = %v, want zero", z)
+	}
+}
+
+// Real and unreal values
+
+func TestBiComplexIsReal(t *testing.T) {
+	v := func(a, b, c, d int64) *BiComplex {
+		return NewBiComplex(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z            *BiComplex
+		real, unreal bool
+	}{
+		{v(0, 0, 0, 0), true, false},
+		{v(3, 0, 0, 0), true, false},
+		{v(0, 0, 1, 0), false, true},
+		{v(0, 5, 0, -1), false, true},
+		{v(1, 0, 1, 0), false, false},
+	}
+	for _, test := range tests {
+		if got := test.z.IsReal(); got != test.real {
+			t.Errorf("IsReal(%v) = %v, want %v", test.z, got, test.real)
+		}
+		if got := test.z.IsUnreal(); got != test.unreal {
+			t.Errorf("IsUnreal(%v) = %v, want %v", test.z, got, test.unreal)
+		}
+	}
+}
+
+// Division
+
+func TestBiComplexQuoExact(t *testing.T) {
+	f := func(x, y *BiComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if y.IsZeroDiv() {
+			return true
+		}
+		p := new(BiComplex).Mul(x, y)
+		return new(BiComplex).Quo(p, y).Equals(x) && p.Quo(p, y).Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBiComplexQuoTruncated(t *testing.T) {
+	b := func(a, b, c, d int64) *BiComplex {
+		return NewBiComplex(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		x, y, want *BiComplex
+	}{
+		{b(3, 0, 0, 0), b(2, 0, 0, 0), b(1, 0, 0, 0)},
+		{b(5, 7, 0, 0), b(0, 0, 2, 0), b(0, 0, 2, 3)},
+		{b(1, 2, 3, 4), b(1, 0, 0, 0), b(1, 2, 3, 4)},
+	}
+	for _, test := range tests {
+		if got := new(BiComplex).Quo(test.x, test.y); !got.Equals(test.want) {
+			t.Errorf("Quo(%v, %v) = %v, want %v", test.x, test.y, got, test.want)
+		}
+	}
+}
+
+func TestBiComplexQuoZeroDivPanics(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	x := NewBiComplex(one, one, zero, zero)
+	y := NewBiComplex(one, zero, one, zero)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Quo(%v, %v) did not panic", x, y)
+		}
+	}()
+	new(BiComplex).Quo(x, y)
+}
@@ -4,6 +4,8 @@
 package integral
 
 import (
+	"errors"
+	"flag"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -13,6 +15,17 @@ import (
 
 var symbCayley = [8]string{"", "i", "j", "k", "m", "n", "p", "q"}
 
+var latexCayley = [8]string{
+	"",
+	`\mathbf{i}`,
+	`\mathbf{j}`,
+	`\mathbf{k}`,
+	`\mathbf{m}`,
+	`\mathbf{n}`,
+	`\mathbf{p}`,
+	`\mathbf{q}`,
+}
+
 // A Cayley represents an integral Cayley octonion.
 type Cayley struct {
 	l, r Hamilton
@@ -23,6 +36,19 @@ func (z *Cayley) Real() *big.Int {
 	return (&z.l).Real()
 }
 
+// Scalar returns the (integral) scalar part of z. It is an alias for Real.
+func (z *Cayley) Scalar() *big.Int {
+	return z.Real()
+}
+
+// Unreal returns the vector part of z, that is, a copy of z with the real part
+// set to zero.
+func (z *Cayley) Unreal() *Cayley {
+	v := new(Cayley).Set(z)
+	v.l.l.l.SetInt64(0)
+	return v
+}
+
 // Cartesian returns the eight integral Cartesian components of z.
 func (z *Cayley) Cartesian() (*big.Int, *big.Int, *big.Int, *big.Int,
 	*big.Int, *big.Int, *big.Int, *big.Int) {
@@ -30,17 +56,38 @@ func (z *Cayley) Cartesian() (*big.Int, *big.Int, *big.Int, *big.Int,
 		&z.r.l.l, &z.r.l.r, &z.r.r.l, &z.r.r.r
 }
 
+// Components returns the eight Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *Cayley) Components() []*big.Int {
+	a, b, c, d, e, f, g, h := z.Cartesian()
+	return []*big.Int{a, b, c, d, e, f, g, h}
+}
+
+// Halves returns the two Hamilton halves of z, so that if the halves are l
+// and r, then z = l + rm in the Cayley-Dickson construction. The returned
+// pointers refer to z itself, like those of Cartesian.
+func (z *Cayley) Halves() (*Hamilton, *Hamilton) {
+	return &z.l, &z.r
+}
+
 // String returns the string representation of a Cayley value.
 //
 // If z corresponds to a + bi + cj + dk + em + fn + gp + hq, then the
 // string is"(a+bi+cj+dk+em+fn+gp+hq)", similar to complex128 values.
 func (z *Cayley) String() string {
+	return z.StringWith(symbCayley)
+}
+
+// StringWith returns the string representation of z with the units written as
+// symb, where symb[0] is the unit of the real part. The layout is the same as
+// that of String.
+func (z *Cayley) StringWith(symb [8]string) string {
 	v := make([]*big.Int, 8)
 	v[0], v[1], v[2], v[3] = z.l.Cartesian()
 	v[4], v[5], v[6], v[7] = z.r.Cartesian()
 	a := make([]string, 17)
 	a[0] = "("
-	a[1] = fmt.Sprintf("%v", v[0])
+	a[1] = fmt.Sprintf("%v%s", v[0], symb[0])
 	i := 1
 	for j := 2; j < 16; j = j + 2 {
 		if v[i].Sign() < 0 {
@@ -48,13 +95,82 @@ func (z *Cayley) String() string {
 		} else {
 			a[j] = fmt.Sprintf("+%v", v[i])
 		}
-		a[j+1] = symbCayley[i]
+		a[j+1] = symb[i]
 		i++
 	}
 	a[16] = ")"
 	return strings.Join(a, "")
 }
 
+// Compact returns a compact string representation of z. It is like String, but
+// zero terms are omitted and unit coefficients are collapsed, so that the
+// string is "(1)" or "(1-q)" instead of listing every component. The zero
+// value gives "(0)".
+func (z *Cayley) Compact() string {
+	v := make([]*big.Int, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	return "(" + compactTerms(v, symbCayley[:]) + ")"
+}
+
+// SetString sets z equal to the value of s, and returns z and a boolean
+// indicating success. The string s must be of the form
+// "(a+bi+cj+dk+em+fn+gp+hq)", where omitted terms are zero. If SetString
+// fails, the value of z is undefined but the returned value is nil.
+func (z *Cayley) SetString(s string) (*Cayley, bool) {
+	v, ok := parseTerms(s, symbCayley[:])
+	if !ok {
+		return nil, false
+	}
+	z.l.l.l.Set(v[0])
+	z.l.l.r.Set(v[1])
+	z.l.r.l.Set(v[2])
+	z.l.r.r.Set(v[3])
+	z.r.l.l.Set(v[4])
+	z.r.l.r.Set(v[5])
+	z.r.r.l.Set(v[6])
+	z.r.r.r.Set(v[7])
+	return z, true
+}
+
+// Flag returns a flag.Value that parses its argument into z.
+func (z *Cayley) Flag() flag.Value {
+	return cayleyValue{z}
+}
+
+// cayleyValue adapts a *Cayley to the flag.Value interface, since the Set
+// method of Cayley is already taken.
+type cayleyValue struct {
+	z *Cayley
+}
+
+func (v cayleyValue) String() string {
+	if v.z == nil {
+		return ""
+	}
+	return v.z.String()
+}
+
+func (v cayleyValue) Set(s string) error {
+	y, ok := new(Cayley).SetString(s)
+	if !ok {
+		return fmt.Errorf("invalid Cayley value %q", s)
+	}
+	v.z.Set(y)
+	return nil
+}
+
+// LaTeX returns a LaTeX representation of z, with the units written as
+// \mathbf{i}, \mathbf{j}, and so on.
+//
+// Zero terms are dropped and unit coefficients are omitted, so that if z
+// corresponds to 1 - i + 2q, then the string is "1-\mathbf{i}+2\mathbf{q}". The
+// zero octonion gives "0".
+func (z *Cayley) LaTeX() string {
+	v := make([]*big.Int, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	return compactTerms(v, latexCayley[:])
+}
+
 // Equals returns true if y and z are equal.
 func (z *Cayley) Equals(y *Cayley) bool {
 	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
@@ -63,6 +179,17 @@ func (z *Cayley) Equals(y *Cayley) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Cayley) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Hamilton))
+}
+
+// IsUnreal returns true if the real part of z is zero and at least one of the
+// other components is not, so that z is a nonzero pure unreal value.
+func (z *Cayley) IsUnreal() bool {
+	return z.l.l.l.Sign() == 0 && !z.IsReal()
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Cayley) Set(y *Cayley) *Cayley {
 	z.l.Set(&y.l)
@@ -70,6 +197,31 @@ func (z *Cayley) Set(y *Cayley) *Cayley {
 	return z
 }
 
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *Cayley) Clone() *Cayley {
+	return new(Cayley).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *Cayley) IsZero() bool {
+	return z.l.IsZero() && z.r.IsZero()
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *Cayley) SetZero() *Cayley {
+	z.l.SetZero()
+	z.r.SetZero()
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *Cayley) SetOne() *Cayley {
+	z.l.SetOne()
+	z.r.SetZero()
+	return z
+}
+
 // NewCayley returns a pointer to the Cayley value a+bi+cj+dk+em+fn+gp+hq.
 func NewCayley(a, b, c, d, e, f, g, h *big.Int) *Cayley {
 	z := new(Cayley)
@@ -84,6 +236,32 @@ func NewCayley(a, b, c, d, e, f, g, h *big.Int) *Cayley {
 	return z
 }
 
+// NewCayleyFromHalves returns a pointer to the Cayley value l + rm made from
+// two Hamilton halves, as returned by Halves.
+func NewCayleyFromHalves(l, r *Hamilton) *Cayley {
+	z := new(Cayley)
+	z.l.Set(l)
+	z.r.Set(r)
+	return z
+}
+
+// FromHamilton sets z equal to the quaternion x, with zero m, n, p, and q
+// components, and returns z. Any three values in the image associate, even
+// though Mul for Cayley is not associative in general.
+func (z *Cayley) FromHamilton(x *Hamilton) *Cayley {
+	z.l.Set(x)
+	z.r.SetZero()
+	return z
+}
+
+// ToHamilton returns a pointer to a new Hamilton value equal to the first of
+// the Halves of z, and true if the other half, which holds the m, n, p, and q
+// components, is zero. Otherwise the first half is still returned, but with
+// false.
+func (z *Cayley) ToHamilton() (*Hamilton, bool) {
+	return new(Hamilton).Set(&z.l), z.r.IsZero()
+}
+
 // Scal sets z equal to y scaled by a, and returns z.
 func (z *Cayley) Scal(y *Cayley, a *big.Int) *Cayley {
 	z.l.Scal(&y.l, a)
@@ -119,6 +297,16 @@ func (z *Cayley) Sub(x, y *Cayley) *Cayley {
 	return z
 }
 
+// Sum sets z equal to the sum of xs, and returns z. If xs is empty, then z is
+// zero.
+func (z *Cayley) Sum(xs ...*Cayley) *Cayley {
+	s := new(Cayley)
+	for _, x := range xs {
+		s.Add(s, x)
+	}
+	return z.Set(s)
+}
+
 // Mul sets z equal to the product of x and y, and returns z.
 //
 // The multiplication rules are:
@@ -184,6 +372,20 @@ func (z *Cayley) Associator(w, x, y *Cayley) *Cayley {
 	)
 }
 
+// Prod sets z equal to the left-nested product of xs, and returns z. That is,
+// Prod(x0, x1, ..., xn) is
+// 		Mul(Mul(Mul(x0, x1), ...), xn)
+// Since multiplication is nonassociative, this generally differs from other
+// groupings of the same factors. If xs is empty, then z is one.
+func (z *Cayley) Prod(xs ...*Cayley) *Cayley {
+	p := new(Cayley)
+	p.l.l.l.SetInt64(1)
+	for _, x := range xs {
+		p.Mul(p, x)
+	}
+	return z.Set(p)
+}
+
 // Quad returns the quadrance of z. If z = a+bi+cj+dk+em+fn+gp+hq, then the
 // quadrance is
 //		Mul(a, a) + Mul(b, b) + Mul(c, c) + Mul(d, d) +
@@ -196,6 +398,93 @@ func (z *Cayley) Quad() *big.Int {
 	)
 }
 
+// Trace returns the reduced trace of z. If z = a+bi+cj+dk+em+fn+gp+hq, then the trace is
+// 		Add(z, Conj(z)) = Mul(2, a)
+// Together with Quad, it gives the coefficients of the minimal polynomial
+// 		Mul(z, z) - Mul(Trace(z), z) + Quad(z) = 0
+func (z *Cayley) Trace() *big.Int {
+	return new(big.Int).Lsh(&z.l.l.l, 1)
+}
+
+// Norm returns the Euclidean norm of z, that is, the square root of Quad(z),
+// computed with prec bits of mantissa. The result is exact only if Quad(z) is a
+// perfect square that fits in prec bits; otherwise it is rounded to nearest
+// even, as by big.Float.Sqrt. If prec is zero, then the precision is that
+// needed to hold Quad(z) exactly.
+func (z *Cayley) Norm(prec uint) *big.Float {
+	norm := new(big.Float).SetPrec(prec).SetInt(z.Quad())
+	return norm.Sqrt(norm)
+}
+
+// Float64s returns the eight Cartesian components of z, each rounded to the
+// nearest float64. Components beyond 2⁵³ in magnitude may lose precision, and
+// components too large for a float64 become ±Inf.
+func (z *Cayley) Float64s() [8]float64 {
+	var v [8]float64
+	l, r := z.l.Float64s(), z.r.Float64s()
+	copy(v[:4], l[:])
+	copy(v[4:], r[:])
+	return v
+}
+
+// Dot returns the Euclidean inner product of z and y, that is, the sum of the
+// products of their eight Cartesian components. This is also the real part of
+// Mul(z, Conj(y)). Note that Dot(z, z) is the quadrance of z.
+func (z *Cayley) Dot(y *Cayley) *big.Int {
+	return new(big.Int).Add(
+		z.l.Dot(&y.l),
+		z.r.Dot(&y.r),
+	)
+}
+
+// Project returns the eight rational Cartesian components of the orthogonal
+// projection of z onto the given value:
+// 		Scal(onto, Dot(z, onto) / Quad(onto))
+// If onto is zero, then Project returns an error.
+func (z *Cayley) Project(onto *Cayley) ([8]*big.Rat, error) {
+	var p [8]*big.Rat
+	if zero := new(Cayley); onto.Equals(zero) {
+		return p, errors.New("projection onto zero")
+	}
+	f := new(big.Rat).SetFrac(z.Dot(onto), onto.Quad())
+	v := make([]*big.Int, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = onto.Cartesian()
+	for i := range p {
+		p[i] = new(big.Rat).SetInt(v[i])
+		p[i].Mul(p[i], f)
+	}
+	return p, nil
+}
+
+// GramSchmidtCayley returns the rational Cartesian components of the vectors
+// obtained by exact Gram-Schmidt orthogonalization of xs, with respect to Dot.
+// The resulting vectors are pairwise orthogonal and span the same space as xs.
+// If an element of xs is linearly dependent on the preceding ones, then the
+// corresponding vector is zero.
+func GramSchmidtCayley(xs []*Cayley) [][8]*big.Rat {
+	ys := make([][8]*big.Rat, len(xs))
+	v := make([]*big.Int, 8)
+	f := new(big.Rat)
+	temp := new(big.Rat)
+	for i, x := range xs {
+		v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = x.Cartesian()
+		for k := range ys[i] {
+			ys[i][k] = new(big.Rat).SetInt(v[k])
+		}
+		for j := 0; j < i; j++ {
+			quad := dotRat(ys[j][:], ys[j][:])
+			if quad.Sign() == 0 {
+				continue
+			}
+			f.Quo(dotRat(ys[i][:], ys[j][:]), quad)
+			for k := range ys[i] {
+				ys[i][k].Sub(ys[i][k], temp.Mul(f, ys[j][k]))
+			}
+		}
+	}
+	return ys
+}
+
 // QuoL sets z equal to the left quotient of x and y:
 // 		Mul(Inv(y), x)
 // Then it returns z. If y is zero, then QuoL panics. Note that
@@ -239,6 +528,107 @@ func (z *Cayley) QuoR(x, y *Cayley) *Cayley {
 	return z
 }
 
+// ExactQuoL sets z equal to the left quotient of x and y, as given by QuoL, and
+// returns z and true, if Quad(y) divides every component of
+// 		Mul(Conj(y), x)
+// so that Mul(y, z) = x. Otherwise z is not modified, and ExactQuoL returns nil
+// and false. If y is zero, then ExactQuoL panics.
+func (z *Cayley) ExactQuoL(x, y *Cayley) (*Cayley, bool) {
+	if zero := new(Cayley); y.Equals(zero) {
+		panic("denominator is zero")
+	}
+	p := new(Cayley).Conj(y)
+	return z.exactQuo(p.Mul(p, x), y.Quad())
+}
+
+// ExactQuoR sets z equal to the right quotient of x and y, as given by QuoR,
+// and returns z and true, if Quad(y) divides every component of
+// 		Mul(x, Conj(y))
+// so that Mul(z, y) = x. Otherwise z is not modified, and ExactQuoR returns nil
+// and false. If y is zero, then ExactQuoR panics.
+func (z *Cayley) ExactQuoR(x, y *Cayley) (*Cayley, bool) {
+	if zero := new(Cayley); y.Equals(zero) {
+		panic("denominator is zero")
+	}
+	p := new(Cayley).Conj(y)
+	return z.exactQuo(p.Mul(x, p), y.Quad())
+}
+
+// exactQuo sets z equal to p divided by d, and returns z and true, if d divides
+// every component of p. Otherwise it returns nil and false.
+func (z *Cayley) exactQuo(p *Cayley, d *big.Int) (*Cayley, bool) {
+	v := make([]*big.Int, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = p.Cartesian()
+	if !quoExact(v, d) {
+		return nil, false
+	}
+	return z.Set(p), true
+}
+
+// InvL sets z equal to the left inverse of y, that is, the value w such that
+// 		Mul(w, y) = 1
+// and returns nil. The left inverse is Conj(y) / Quad(y), which is integral
+// only if Quad(y) divides every component. If y is zero, then InvL returns
+// ErrZeroDivisor; if the left inverse is not integral, then InvL returns
+// ErrNotIntegral. In both cases z is not modified.
+func (z *Cayley) InvL(y *Cayley) error {
+	return z.inv(y)
+}
+
+// InvR sets z equal to the right inverse of y, that is, the value w such that
+// 		Mul(y, w) = 1
+// and returns nil. The errors are the same as those of InvL. Since the
+// algebra is alternative, the right inverse equals the left inverse, but both
+// are provided to make the side explicit.
+func (z *Cayley) InvR(y *Cayley) error {
+	return z.inv(y)
+}
+
+// inv sets z equal to Conj(y) / Quad(y), and returns nil, if y is nonzero and
+// the result is integral.
+func (z *Cayley) inv(y *Cayley) error {
+	if zero := new(Cayley); y.Equals(zero) {
+		return ErrZeroDivisor
+	}
+	c := new(Cayley).Conj(y)
+	v := make([]*big.Int, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = c.Cartesian()
+	if !quoExact(v, y.Quad()) {
+		return ErrNotIntegral
+	}
+	z.Set(c)
+	return nil
+}
+
+// Pow sets z equal to x raised to the nth power, and returns z. Binary
+// exponentiation is used. Multiplication is nonassociative, but it is
+// power-associative: all the powers of x lie in an associative subalgebra, so
+// the result agrees with the left-nested product
+// 		Mul(Mul(Mul(x, x), x), ...)
+// If n is zero, then z is one. If n is negative, then z is the left quotient
+// of one and x raised to the -n power, as given by QuoL, so Pow panics if x is
+// zero. Since one is real, QuoL and QuoR agree here.
+func (z *Cayley) Pow(x *Cayley, n int) *Cayley {
+	if n < 0 {
+		p := new(Cayley).Pow(x, -n)
+		one := new(Cayley)
+		one.l.l.l.SetInt64(1)
+		return z.QuoL(one, p)
+	}
+	p := new(Cayley).Set(x)
+	z.Set(new(Cayley))
+	z.l.l.l.SetInt64(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			z.Mul(z, p)
+		}
+		if n > 1 {
+			p.Mul(p, p)
+		}
+	}
+	return z
+}
+
 // Generate returns a random Cayley value for quick.Check testing.
 func (z *Cayley) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomCayley := &Cayley{
@@ -4,6 +4,7 @@
 package integral
 
 import (
+	"math"
 	"math/big"
 	"testing"
 	"testing/quick"
@@ -335,3 +336,600 @@ func TestCayleyComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Parsing
+
+func TestCayleyStringSetString(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		l, ok := new(Cayley).SetString(x.String())
+		return ok && l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Formatting
+
+func TestCayleyLaTeX(t *testing.T) {
+	c := func(a ...int64) *Cayley {
+		v := make([]*big.Int, 8)
+		for i := range v {
+			v[i] = big.NewInt(a[i])
+		}
+		return NewCayley(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7])
+	}
+	var tests = []struct {
+		z    *Cayley
+		want string
+	}{
+		{c(0, 0, 0, 0, 0, 0, 0, 0), `0`},
+		{c(1, 0, 0, 0, 0, 0, 0, 0), `1`},
+		{c(-1, 0, 0, 0, 0, 0, 0, 0), `-1`},
+		{c(0, 1, 0, 0, 0, 0, 0, 0), `\mathbf{i}`},
+		{c(0, -1, 0, 0, 0, 0, 0, 0), `-\mathbf{i}`},
+		{c(1, -1, 0, 0, 0, 0, 0, 2), `1-\mathbf{i}+2\mathbf{q}`},
+		{c(0, 0, -3, 4, 1, -1, 0, 0), `-3\mathbf{j}+4\mathbf{k}+\mathbf{m}-\mathbf{n}`},
+		{c(0, 0, 0, 0, 0, 0, 12, 0), `12\mathbf{p}`},
+	}
+	for _, test := range tests {
+		if got := test.z.LaTeX(); got != test.want {
+			t.Errorf("LaTeX(%v) = %q, want %q", test.z, got, test.want)
+		}
+	}
+}
+
+func TestCayleyStringWith(t *testing.T) {
+	z := NewCayley(big.NewInt(1), big.NewInt(-2), big.NewInt(3), big.NewInt(-4),
+		big.NewInt(5), big.NewInt(-6), big.NewInt(7), big.NewInt(-8))
+	symb := [8]string{"e₀", "e₁", "e₂", "e₃", "e₄", "e₅", "e₆", "e₇"}
+	want := "(1e₀-2e₁+3e₂-4e₃+5e₄-6e₅+7e₆-8e₇)"
+	if got := z.StringWith(symb); got != want {
+		t.Errorf("StringWith(%v) = %q, want %q", symb, got, want)
+	}
+	if got, want := z.StringWith(symbCayley), z.String(); got != want {
+		t.Errorf("StringWith(%v) = %q, want %q", symbCayley, got, want)
+	}
+}
+
+func TestCayleyCompact(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	var tests = []struct {
+		z    *Cayley
+		want string
+	}{
+		{new(Cayley), "(0)"},
+		{NewCayley(one, zero, zero, zero, zero, zero, zero, zero), "(1)"},
+		{NewCayley(one, zero, zero, zero, zero, zero, zero, one), "(1+q)"},
+		{NewCayley(zero, big.NewInt(-1), zero, big.NewInt(3), zero, zero, big.NewInt(-7), zero), "(-i+3k-7p)"},
+	}
+	for _, test := range tests {
+		if got := test.z.Compact(); got != test.want {
+			t.Errorf("Compact(%v) = %q, want %q", test.z, got, test.want)
+		}
+	}
+}
+
+// Projection
+
+func TestCayleyProjectBasis(t *testing.T) {
+	z := NewCayley(big.NewInt(1), big.NewInt(-2), big.NewInt(3), big.NewInt(-4),
+		big.NewInt(5), big.NewInt(-6), big.NewInt(7), big.NewInt(-8))
+	v := make([]*big.Int, 8)
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.Cartesian()
+	for i := 0; i < 8; i++ {
+		e := make([]*big.Int, 8)
+		for j := range e {
+			e[j] = new(big.Int)
+		}
+		e[i].SetInt64(3)
+		onto := NewCayley(e[0], e[1], e[2], e[3], e[4], e[5], e[6], e[7])
+		p, err := z.Project(onto)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := range p {
+			want := new(big.Rat)
+			if j == i {
+				want.SetInt(v[i])
+			}
+			if p[j].Cmp(want) != 0 {
+				t.Errorf("Project(%v, %v)[%v] = %v, want %v", z, onto, j, p[j], want)
+			}
+		}
+	}
+}
+
+func TestCayleyProjectResidualOrthogonal(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p, err := x.Project(y)
+		if err != nil {
+			return false
+		}
+		v := make([]*big.Int, 8)
+		w := make([]*big.Int, 8)
+		v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = x.Cartesian()
+		w[0], w[1], w[2], w[3], w[4], w[5], w[6], w[7] = y.Cartesian()
+		dot, temp := new(big.Rat), new(big.Rat)
+		for i := range p {
+			temp.Sub(new(big.Rat).SetInt(v[i]), p[i])
+			dot.Add(dot, temp.Mul(temp, new(big.Rat).SetInt(w[i])))
+		}
+		return dot.Sign() == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyProjectZero(t *testing.T) {
+	z := NewCayley(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4),
+		big.NewInt(5), big.NewInt(6), big.NewInt(7), big.NewInt(8))
+	if _, err := z.Project(new(Cayley)); err == nil {
+		t.Error("Project onto zero succeeded, want error")
+	}
+}
+
+// Powers
+
+func TestCayleyPowLeftFold(t *testing.T) {
+	f := func(x *Cayley, n uint8) bool {
+		// t.Logf("x = %v, n = %v", x, n)
+		n = n % 12
+		r := new(Cayley)
+		r.l.l.l.SetInt64(1)
+		for i := uint8(0); i < n; i++ {
+			r.Mul(r, x)
+		}
+		return new(Cayley).Pow(x, int(n)).Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyPowNegative(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	q := NewCayley(zero, zero, zero, zero, zero, zero, zero, one)
+	var tests = []struct {
+		n    int
+		want *Cayley
+	}{
+		{-1, NewCayley(zero, zero, zero, zero, zero, zero, zero, big.NewInt(-1))},
+		{-2, NewCayley(big.NewInt(-1), zero, zero, zero, zero, zero, zero, zero)},
+		{-4, NewCayley(one, zero, zero, zero, zero, zero, zero, zero)},
+	}
+	for _, test := range tests {
+		if got := new(Cayley).Pow(q, test.n); !got.Equals(test.want) {
+			t.Errorf("Pow(%v, %v) = %v, want %v", q, test.n, got, test.want)
+		}
+	}
+}
+
+// Orthogonalization
+
+func TestGramSchmidtCayley(t *testing.T) {
+	f := func(x, y, z *Cayley) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		w := new(Cayley).Add(x, new(Cayley).Scal(y, big.NewInt(3)))
+		ys := GramSchmidtCayley([]*Cayley{x, y, w, z})
+		if len(ys) != 4 {
+			return false
+		}
+		for i := range ys {
+			for j := i + 1; j < len(ys); j++ {
+				if dotRat(ys[i][:], ys[j][:]).Sign() != 0 {
+					return false
+				}
+			}
+		}
+		// The third vector depends on the first two.
+		return dotRat(ys[2][:], ys[2][:]).Sign() == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Sums
+
+func TestCayleySum(t *testing.T) {
+	f := func(x, y, w *Cayley) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(Cayley).Sum(x, y, w)
+		r := new(Cayley).Add(new(Cayley).Add(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if z := new(Cayley).Sum(); !z.Equals(new(Cayley)) {
+		t.Errorf("Sum() = %v, want zero", z)
+	}
+}
+
+// Products
+
+func TestCayleyProdLeftNested(t *testing.T) {
+	f := func(x, y, w, v *Cayley) bool {
+		// t.Logf("x = %v, y = %v, w = %v, v = %v", x, y, w, v)
+		l := new(Cayley).Prod(x, y, w, v)
+		r := new(Cayley).Mul(x, y)
+		r.Mul(r, w)
+		r.Mul(r, v)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	zero := new(big.Int)
+	one := NewCayley(big.NewInt(1), zero, zero, zero, zero, zero, zero, zero)
+	if z := new(Cayley).Prod(); !z.Equals(one) {
+		t.Errorf("Prod() = %v, want %v", z, one)
+	}
+}
+
+// Inverses
+
+func TestCayleyInv(t *testing.T) {
+	o := func(v ...int64) *Cayley {
+		w := make([]*big.Int, 8)
+		for i := range w {
+			w[i] = big.NewInt(v[i])
+		}
+		return NewCayley(w[0], w[1], w[2], w[3], w[4], w[5], w[6], w[7])
+	}
+	one := o(1, 0, 0, 0, 0, 0, 0, 0)
+	units := []*Cayley{
+		o(1, 0, 0, 0, 0, 0, 0, 0),
+		o(0, -1, 0, 0, 0, 0, 0, 0),
+		o(0, 0, 0, 0, 1, 0, 0, 0),
+		o(0, 0, 0, 0, 0, 0, 0, -1),
+	}
+	for _, y := range units {
+		l, r := new(Cayley), new(Cayley)
+		if err := l.InvL(y); err != nil {
+			t.Errorf("InvL(%v) = %v", y, err)
+		} else if p := new(Cayley).Mul(l, y); !p.Equals(one) {
+			t.Errorf("Mul(InvL(%v), %v) = %v, want %v", y, y, p, one)
+		}
+		if err := r.InvR(y); err != nil {
+			t.Errorf("InvR(%v) = %v", y, err)
+		} else if p := new(Cayley).Mul(y, r); !p.Equals(one) {
+			t.Errorf("Mul(%v, InvR(%v)) = %v, want %v", y, y, p, one)
+		}
+	}
+	var tests = []struct {
+		y   *Cayley
+		err error
+	}{
+		{o(0, 0, 0, 0, 0, 0, 0, 0), ErrZeroDivisor},
+		{o(1, 1, 0, 0, 0, 0, 0, 0), ErrNotIntegral},
+		{o(0, 0, 0, 0, 0, 2, 0, 0), ErrNotIntegral},
+	}
+	for _, test := range tests {
+		z := new(Cayley).Set(one)
+		if err := z.InvL(test.y); err != test.err {
+			t.Errorf("InvL(%v) = %v, want %v", test.y, err, test.err)
+		}
+		if err := z.InvR(test.y); err != test.err {
+			t.Errorf("InvR(%v) = %v, want %v", test.y, err, test.err)
+		}
+		if !z.Equals(one) {
+			t.Errorf("failed inverse of %v modified z to %v", test.y, z)
+		}
+	}
+}
+
+// Exact division
+
+func TestCayleyExactQuo(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if zero := new(Cayley); y.Equals(zero) {
+			return true
+		}
+		l, okL := new(Cayley).ExactQuoL(new(Cayley).Mul(y, x), y)
+		r, okR := new(Cayley).ExactQuoR(new(Cayley).Mul(x, y), y)
+		return okL && okR && l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	o := func(v ...int64) *Cayley {
+		w := make([]*big.Int, 8)
+		for i := range w {
+			w[i] = big.NewInt(v[i])
+		}
+		return NewCayley(w[0], w[1], w[2], w[3], w[4], w[5], w[6], w[7])
+	}
+	var tests = []struct {
+		x, y *Cayley
+	}{
+		{o(1, 0, 0, 0, 0, 0, 0, 0), o(2, 0, 0, 0, 0, 0, 0, 0)},
+		{o(1, 1, 1, 1, 0, 0, 0, 0), o(2, 0, 0, 0, 0, 0, 0, 0)},
+		{o(3, 0, 0, 0, 0, 0, 0, 0), o(1, 0, 0, 0, 1, 0, 0, 0)},
+	}
+	for _, test := range tests {
+		z := o(7, 7, 7, 7, 7, 7, 7, 7)
+		if q, ok := z.ExactQuoL(test.x, test.y); ok || q != nil {
+			t.Errorf("ExactQuoL(%v, %v) = %v, %v, want nil, false", test.x, test.y, q, ok)
+		}
+		if q, ok := z.ExactQuoR(test.x, test.y); ok || q != nil {
+			t.Errorf("ExactQuoR(%v, %v) = %v, %v, want nil, false", test.x, test.y, q, ok)
+		}
+		if !z.Equals(o(7, 7, 7, 7, 7, 7, 7, 7)) {
+			t.Errorf("inexact division by %v modified z to %v", test.y, z)
+		}
+	}
+}
+
+// Norms
+
+func TestCayleyNorm(t *testing.T) {
+	o := func(v ...int64) *Cayley {
+		w := make([]*big.Int, 8)
+		for i := range w {
+			w[i] = big.NewInt(v[i])
+		}
+		return NewCayley(w[0], w[1], w[2], w[3], w[4], w[5], w[6], w[7])
+	}
+	var tests = []struct {
+		z    *Cayley
+		want int64
+	}{
+		{o(0, 0, 0, 0, 0, 0, 0, 0), 0},
+		{o(1, 1, 1, 1, 0, 0, 0, 0), 2},
+		{o(0, 0, 0, 0, 2, -1, 0, 2), 3},
+		{o(0, 0, 0, 3, 0, 0, 0, -4), 5},
+	}
+	for _, test := range tests {
+		if got := test.z.Norm(53); got.Cmp(big.NewFloat(float64(test.want))) != 0 {
+			t.Errorf("Norm(%v, 53) = %v, want %v", test.z, got, test.want)
+		}
+	}
+}
+
+func TestCayleyNormSquare(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		const prec = 256
+		norm := x.Norm(prec)
+		if norm.Prec() != prec {
+			return false
+		}
+		// The square of the norm is within a few ulps of the quadrance.
+		quad := new(big.Float).SetInt(x.Quad())
+		diff := new(big.Float).Mul(norm, norm)
+		diff.Sub(diff, quad)
+		return diff.Sign() == 0 || diff.MantExp(nil) <= quad.MantExp(nil)+4-prec
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Inner products
+
+func TestCayleyDotQuad(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		return x.Dot(x).Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyDotRealPart(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p := new(Cayley).Mul(x, new(Cayley).Conj(y))
+		return x.Dot(y).Cmp(y.Dot(x)) == 0 && x.Dot(y).Cmp(p.Real()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Halves
+
+func TestCayleyHalves(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		l, r := x.Halves()
+		a, b, c, d, e, f, g, h := x.Cartesian()
+		if !l.Equals(NewHamilton(a, b, c, d)) || !r.Equals(NewHamilton(e, f, g, h)) {
+			return false
+		}
+		return NewCayleyFromHalves(l, r).Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewCayleyFromHalves(t *testing.T) {
+	f := func(l, r *Hamilton) bool {
+		// t.Logf("l = %v, r = %v", l, r)
+		gotL, gotR := NewCayleyFromHalves(l, r).Halves()
+		return gotL.Equals(l) && gotR.Equals(r) && gotL != l && gotR != r
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Traces
+
+func TestCayleyTrace(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		one := new(Cayley).Pow(x, 0)
+		l := new(Cayley).Add(x, new(Cayley).Conj(x))
+		r := new(Cayley).Scal(one, x.Trace())
+		if !l.Equals(r) {
+			return false
+		}
+		// Mul(x, x) - Mul(Trace(x), x) + Quad(x) = 0
+		p := new(Cayley).Mul(x, x)
+		p.Sub(p, new(Cayley).Scal(x, x.Trace()))
+		p.Add(p, one.Scal(one, x.Quad()))
+		return p.Equals(new(Cayley))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Scalar and vector parts
+
+func TestCayleyUnreal(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		a := new(big.Int).Rsh(x.Trace(), 1)
+		if x.Scalar().Cmp(a) != 0 {
+			return false
+		}
+		v := x.Unreal()
+		one := new(Cayley).Pow(x, 0)
+		d := new(Cayley).Sub(x, v)
+		return v.Trace().Sign() == 0 && d.Equals(one.Scal(one, a))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Real and unreal values
+
+func TestCayleyIsReal(t *testing.T) {
+	v := func(a ...int64) *Cayley {
+		w := make([]*big.Int, 8)
+		for i := range w {
+			w[i] = big.NewInt(a[i])
+		}
+		return NewCayley(w[0], w[1], w[2], w[3], w[4], w[5], w[6], w[7])
+	}
+	var tests = []struct {
+		z            *Cayley
+		real, unreal bool
+	}{
+		{v(0, 0, 0, 0, 0, 0, 0, 0), true, false},
+		{v(3, 0, 0, 0, 0, 0, 0, 0), true, false},
+		{v(0, 0, 0, 0, 0, 0, 0, -2), false, true},
+		{v(0, 5, 0, 0, 0, 0, 0, 0), false, true},
+		{v(1, 0, 0, 0, 0, 0, 0, 1), false, false},
+	}
+	for _, test := range tests {
+		if got := test.z.IsReal(); got != test.real {
+			t.Errorf("IsReal(%v) = %v, want %v", test.z, got, test.real)
+		}
+		if got := test.z.IsUnreal(); got != test.unreal {
+			t.Errorf("IsUnreal(%v) = %v, want %v", test.z, got, test.unreal)
+		}
+	}
+}
+
+// Identities
+
+func TestCayleyIdentities(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		zero := new(Cayley).Set(y).SetZero()
+		one := new(Cayley).Set(y).SetOne()
+		if !zero.IsZero() || !zero.Equals(new(Cayley)) || one.IsZero() {
+			return false
+		}
+		if x.IsZero() != x.Equals(zero) {
+			return false
+		}
+		l := new(Cayley).Mul(x, one)
+		r := new(Cayley).Mul(one, x)
+		return new(Cayley).Add(x, zero).Equals(x) && l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Embeddings
+
+func TestCayleyFromHamilton(t *testing.T) {
+	f := func(w, x, y *Hamilton) bool {
+		// t.Logf("w = %v, x = %v, y = %v", w, x, y)
+		a, b, c := new(Cayley).FromHamilton(w), new(Cayley).FromHamilton(x), new(Cayley).FromHamilton(y)
+		if z, ok := a.ToHamilton(); !ok || !z.Equals(w) {
+			return false
+		}
+		prod := new(Cayley).FromHamilton(new(Hamilton).Mul(w, x))
+		return prod.Equals(new(Cayley).Mul(a, b)) &&
+			new(Cayley).Associator(a, b, c).IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyToHamilton(t *testing.T) {
+	f := func(l, r *Hamilton) bool {
+		// t.Logf("l = %v, r = %v", l, r)
+		y, ok := NewCayleyFromHalves(l, r).ToHamilton()
+		return y.Equals(l) && ok == r.IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyFromHamiltonAssociator(t *testing.T) {
+	zero, one := new(big.Int), big.NewInt(1)
+	i := new(Cayley).FromHamilton(NewHamilton(zero, one, zero, zero))
+	j := new(Cayley).FromHamilton(NewHamilton(zero, zero, one, zero))
+	m := NewCayley(zero, zero, zero, zero, one, zero, zero, zero)
+	if new(Cayley).Associator(i, j, m).IsZero() {
+		t.Errorf("Associator(%v, %v, %v) = 0, want nonzero", i, j, m)
+	}
+}
+
+// Conversions
+
+func TestCayleyFloat64s(t *testing.T) {
+	o := func(v ...int64) *Cayley {
+		w := make([]*big.Int, 8)
+		for i := range w {
+			w[i] = big.NewInt(v[i])
+		}
+		return NewCayley(w[0], w[1], w[2], w[3], w[4], w[5], w[6], w[7])
+	}
+	huge := new(Cayley)
+	huge.r.r.r.Lsh(big.NewInt(-1), 1024)
+	var tests = []struct {
+		x    *Cayley
+		want [8]float64
+	}{
+		{new(Cayley), [8]float64{}},
+		{o(1, -2, 3, -4, 5, -6, 7, -8), [8]float64{1, -2, 3, -4, 5, -6, 7, -8}},
+		{huge, [8]float64{0, 0, 0, 0, 0, 0, 0, math.Inf(-1)}},
+	}
+	for _, test := range tests {
+		if got := test.x.Float64s(); got != test.want {
+			t.Errorf("Float64s(%v) = %v, want %v", test.x, got, test.want)
+		}
+	}
+}
+
+func TestCayleyFloat64sHalves(t *testing.T) {
+	f := func(x *Cayley) bool {
+		// t.Logf("x = %v", x)
+		v := x.Float64s()
+		l, r := x.Halves()
+		return [4]float64{v[0], v[1], v[2], v[3]} == l.Float64s() &&
+			[4]float64{v[4], v[5], v[6], v[7]} == r.Float64s()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
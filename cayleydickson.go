@@ -0,0 +1,237 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// An Algebra is an integral algebra with a conjugation, suitable as a half of
+// a CayleyDickson value. The type parameter T is the underlying struct type, so
+// that an Algebra is a pointer type like *Complex or *Hamilton. Components
+// returns pointers to the integral components of the value, so that they can
+// be read and set through it.
+type Algebra[T any] interface {
+	*T
+	Components() []*big.Int
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Mul(x, y *T) *T
+	Conj(y *T) *T
+	Neg(y *T) *T
+	Set(y *T) *T
+	Equals(y *T) bool
+}
+
+// A Doubling chooses the square of the new imaginary unit introduced by the
+// Cayley-Dickson construction. Sign returns -1, 0, or +1.
+type Doubling interface {
+	Sign() int
+}
+
+// ComplexDoubling is the Doubling with Sign -1, as used by Hamilton and Cayley.
+type ComplexDoubling struct{}
+
+// Sign returns -1.
+func (ComplexDoubling) Sign() int { return -1 }
+
+// PerplexDoubling is the Doubling with Sign +1, as used by Cockle.
+type PerplexDoubling struct{}
+
+// Sign returns +1.
+func (PerplexDoubling) Sign() int { return +1 }
+
+// InfraDoubling is the Doubling with Sign 0, as used by InfraComplex, Supra,
+// and InfraPerplex.
+type InfraDoubling struct{}
+
+// Sign returns 0.
+func (InfraDoubling) Sign() int { return 0 }
+
+// A CayleyDickson represents a value l + ru obtained by the Cayley-Dickson
+// construction from two halves of type T, where the new imaginary unit u
+// squares to the Sign of D. Since *CayleyDickson is itself an Algebra, the
+// construction can be repeated. For example,
+// 		CayleyDickson[Complex, *Complex, ComplexDoubling]
+// behaves like Hamilton, and doubling that again behaves like Cayley.
+//
+// The concrete types of this package are kept, since they are faster and have
+// more methods; CayleyDickson is meant for building new levels.
+type CayleyDickson[T any, P Algebra[T], D Doubling] struct {
+	l, r T
+}
+
+// NewCayleyDickson returns a pointer to the CayleyDickson value l + ru.
+func NewCayleyDickson[T any, P Algebra[T], D Doubling](l, r P) *CayleyDickson[T, P, D] {
+	z := new(CayleyDickson[T, P, D])
+	P(&z.l).Set(l)
+	P(&z.r).Set(r)
+	return z
+}
+
+// Halves returns the two halves l and r of z = l + ru. The returned pointers
+// refer to z itself.
+func (z *CayleyDickson[T, P, D]) Halves() (P, P) {
+	return &z.l, &z.r
+}
+
+// String returns the string representation of z. If the Components of z are
+// a, b, c, and so on, then the string is "(a+be1+ce2+...)".
+func (z *CayleyDickson[T, P, D]) String() string {
+	v := z.Components()
+	if len(v) == 0 {
+		return "()"
+	}
+	a := make([]string, 0, 2*len(v)+1)
+	a = append(a, "(", fmt.Sprintf("%v", v[0]))
+	for i := 1; i < len(v); i++ {
+		if v[i].Sign() < 0 {
+			a = append(a, fmt.Sprintf("%ve%d", v[i], i))
+		} else {
+			a = append(a, fmt.Sprintf("+%ve%d", v[i], i))
+		}
+	}
+	a = append(a, ")")
+	return strings.Join(a, "")
+}
+
+// Equals returns true if y and z are equal.
+func (z *CayleyDickson[T, P, D]) Equals(y *CayleyDickson[T, P, D]) bool {
+	return P(&z.l).Equals(&y.l) && P(&z.r).Equals(&y.r)
+}
+
+// Set sets z equal to y, and returns z.
+func (z *CayleyDickson[T, P, D]) Set(y *CayleyDickson[T, P, D]) *CayleyDickson[T, P, D] {
+	P(&z.l).Set(&y.l)
+	P(&z.r).Set(&y.r)
+	return z
+}
+
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *CayleyDickson[T, P, D]) Clone() *CayleyDickson[T, P, D] {
+	return new(CayleyDickson[T, P, D]).Set(z)
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *CayleyDickson[T, P, D]) Neg(y *CayleyDickson[T, P, D]) *CayleyDickson[T, P, D] {
+	P(&z.l).Neg(&y.l)
+	P(&z.r).Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z. If y = l + ru, then
+// the conjugate is Conj(l) - ru.
+func (z *CayleyDickson[T, P, D]) Conj(y *CayleyDickson[T, P, D]) *CayleyDickson[T, P, D] {
+	P(&z.l).Conj(&y.l)
+	P(&z.r).Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *CayleyDickson[T, P, D]) Add(x, y *CayleyDickson[T, P, D]) *CayleyDickson[T, P, D] {
+	P(&z.l).Add(&x.l, &y.l)
+	P(&z.r).Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *CayleyDickson[T, P, D]) Sub(x, y *CayleyDickson[T, P, D]) *CayleyDickson[T, P, D] {
+	P(&z.l).Sub(&x.l, &y.l)
+	P(&z.r).Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z. If x = a + bu and
+// y = c + du, then the product is
+// 		(Mul(a, c) + s Mul(Conj(d), b)) + (Mul(d, a) + Mul(b, Conj(c)))u
+// where s is the Sign of D. This is the same rule used by the concrete types.
+func (z *CayleyDickson[T, P, D]) Mul(x, y *CayleyDickson[T, P, D]) *CayleyDickson[T, P, D] {
+	var a, b, c, d, temp T
+	P(&a).Set(&x.l)
+	P(&b).Set(&x.r)
+	P(&c).Set(&y.l)
+	P(&d).Set(&y.r)
+	P(&z.l).Mul(&a, &c)
+	var s D
+	switch s.Sign() {
+	case -1:
+		P(&z.l).Sub(&z.l, P(&temp).Mul(P(&temp).Conj(&d), &b))
+	case +1:
+		P(&z.l).Add(&z.l, P(&temp).Mul(P(&temp).Conj(&d), &b))
+	}
+	P(&z.r).Add(
+		P(&z.r).Mul(&d, &a),
+		P(&temp).Mul(&b, P(&temp).Conj(&c)),
+	)
+	return z
+}
+
+// HasZeroDivisors returns true if the algebra of z has a nonzero zero divisor
+// whose Components are all at most bound in absolute value. The value of z
+// itself is not used. For each candidate x, in order of increasing number of
+// nonzero components, the existence of a nonzero y with Mul(x, y) = 0 is
+// decided exactly, by checking whether the integral matrix of left
+// multiplication by x is singular; y need not lie within the bound.
+//
+// A result of true is conclusive. A result of false only means that no zero
+// divisor was found within the bound, although it is also conclusive for the
+// division algebras, which have none.
+func (z *CayleyDickson[T, P, D]) HasZeroDivisors(bound int64) bool {
+	n := len(new(CayleyDickson[T, P, D]).Components())
+	basis := make([]*CayleyDickson[T, P, D], n)
+	for i := range basis {
+		basis[i] = new(CayleyDickson[T, P, D])
+		basis[i].Components()[i].SetInt64(1)
+	}
+	x := new(CayleyDickson[T, P, D])
+	v := x.Components()
+	singular := func() bool {
+		rows := make([][]*big.Int, n)
+		for i, e := range basis {
+			rows[i] = new(CayleyDickson[T, P, D]).Mul(x, e).Components()
+		}
+		return rank(rows) < n
+	}
+	// search assigns nonzero values to k more components, starting at index i.
+	// The first nonzero component is kept positive, since x and -x are zero
+	// divisors together.
+	var search func(i, k int) bool
+	search = func(i, k int) bool {
+		if k == 0 {
+			return singular()
+		}
+		for j := i; j <= n-k; j++ {
+			lo := -bound
+			if i == 0 {
+				lo = 1
+			}
+			for a := lo; a <= bound; a++ {
+				if a == 0 {
+					continue
+				}
+				v[j].SetInt64(a)
+				if search(j+1, k-1) {
+					return true
+				}
+			}
+			v[j].SetInt64(0)
+		}
+		return false
+	}
+	for k := 1; k <= n; k++ {
+		if search(0, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// Components returns the components of the half l followed by those of the
+// half r. The returned pointers refer to z itself.
+func (z *CayleyDickson[T, P, D]) Components() []*big.Int {
+	return append(P(&z.l).Components(), P(&z.r).Components()...)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+type (
+	hamiltonCD = CayleyDickson[Complex, *Complex, ComplexDoubling]
+	cayleyCD   = CayleyDickson[hamiltonCD, *hamiltonCD, ComplexDoubling]
+	sedenionCD = CayleyDickson[cayleyCD, *cayleyCD, ComplexDoubling]
+	cockleCD   = CayleyDickson[Complex, *Complex, PerplexDoubling]
+	supraCD    = CayleyDickson[Infra, *Infra, InfraDoubling]
+)
+
+// setComponents copies the components of x onto those of y.
+func setComponents(y, x interface{ Components() []*big.Int }) {
+	v, w := y.Components(), x.Components()
+	for i := range v {
+		v[i].Set(w[i])
+	}
+}
+
+// Re-expressing the concrete types
+
+func TestCayleyDicksonHamilton(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b := new(hamiltonCD), new(hamiltonCD)
+		setComponents(a, x)
+		setComponents(b, y)
+		p := new(Hamilton).Mul(x, y)
+		q := new(hamiltonCD).Mul(a, b)
+		c := new(Hamilton).Conj(x)
+		d := new(hamiltonCD).Conj(a)
+		r, s := new(Hamilton), new(Hamilton)
+		setComponents(r, q)
+		setComponents(s, d)
+		return r.Equals(p) && s.Equals(c)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyDicksonCayley(t *testing.T) {
+	f := func(x, y *Cayley) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b := new(cayleyCD), new(cayleyCD)
+		setComponents(a, x)
+		setComponents(b, y)
+		q := new(cayleyCD).Mul(a, b)
+		r := new(Cayley)
+		setComponents(r, q)
+		return r.Equals(new(Cayley).Mul(x, y))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyDicksonCockle(t *testing.T) {
+	f := func(x, y *Cockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b := new(cockleCD), new(cockleCD)
+		setComponents(a, x)
+		setComponents(b, y)
+		q := new(cockleCD).Mul(a, b)
+		r := new(Cockle)
+		setComponents(r, q)
+		return r.Equals(new(Cockle).Mul(x, y))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyDicksonSupra(t *testing.T) {
+	f := func(x, y *Supra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b := new(supraCD), new(supraCD)
+		setComponents(a, x)
+		setComponents(b, y)
+		q := new(supraCD).Mul(a, b)
+		r := new(Supra)
+		setComponents(r, q)
+		return r.Equals(new(Supra).Mul(x, y))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCayleyDicksonSedenion(t *testing.T) {
+	f := func(x, y *Sedenion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b := new(sedenionCD), new(sedenionCD)
+		setComponents(a, x)
+		setComponents(b, y)
+		q := new(sedenionCD).Mul(a, b)
+		r := new(Sedenion)
+		setComponents(r, q)
+		return r.Equals(new(Sedenion).Mul(x, y))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewCayleyDickson(t *testing.T) {
+	l := NewComplex(big.NewInt(1), big.NewInt(-2))
+	r := NewComplex(big.NewInt(0), big.NewInt(3))
+	z := NewCayleyDickson[Complex, *Complex, ComplexDoubling](l, r)
+	if got, want := z.String(), "(1-2e1+0e2+3e3)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	a, b := z.Halves()
+	if !a.Equals(l) || !b.Equals(r) {
+		t.Errorf("Halves() = %v, %v, want %v, %v", a, b, l, r)
+	}
+}
+
+func TestCayleyDicksonZorn(t *testing.T) {
+	// Zorn has no Cartesian method, so this depends on Components alone.
+	z := new(CayleyDickson[Zorn, *Zorn, ComplexDoubling])
+	l, r := z.Halves()
+	l.SetOne()
+	r.Components()[7].SetInt64(-4)
+	want := "(1+0e1+0e2+0e3+0e4+0e5+0e6+1e7+0e8+0e9+0e10+0e11+0e12+0e13+0e14-4e15)"
+	if got := z.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if !z.HasZeroDivisors(1) {
+		t.Errorf("HasZeroDivisors(1) = false, want true")
+	}
+}
+
+// Zero divisors
+
+// An integer is a big.Int with the methods of an Algebra, where Conj is the
+// identity. The integers are not a type of this package, so doubling them is
+// the only way to put Complex and Perplex in the same tower as the others.
+type integer struct {
+	big.Int
+}
+
+func (z *integer) Add(x, y *integer) *integer { z.Int.Add(&x.Int, &y.Int); return z }
+func (z *integer) Sub(x, y *integer) *integer { z.Int.Sub(&x.Int, &y.Int); return z }
+func (z *integer) Mul(x, y *integer) *integer { z.Int.Mul(&x.Int, &y.Int); return z }
+func (z *integer) Conj(y *integer) *integer   { z.Int.Set(&y.Int); return z }
+func (z *integer) Neg(y *integer) *integer    { z.Int.Neg(&y.Int); return z }
+func (z *integer) Set(y *integer) *integer    { z.Int.Set(&y.Int); return z }
+func (z *integer) Equals(y *integer) bool     { return z.Int.Cmp(&y.Int) == 0 }
+func (z *integer) Components() []*big.Int     { return []*big.Int{&z.Int} }
+
+type (
+	complexCD = CayleyDickson[integer, *integer, ComplexDoubling]
+	perplexCD = CayleyDickson[integer, *integer, PerplexDoubling]
+)
+
+func TestCayleyDicksonHasZeroDivisors(t *testing.T) {
+	var tests = []struct {
+		name string
+		has  func(bound int64) bool
+		want bool
+	}{
+		{"Complex", new(complexCD).HasZeroDivisors, false},
+		{"Perplex", new(perplexCD).HasZeroDivisors, true},
+		{"Hamilton", new(hamiltonCD).HasZeroDivisors, false},
+		{"Cayley", new(cayleyCD).HasZeroDivisors, false},
+		{"Sedenion", new(sedenionCD).HasZeroDivisors, true},
+		{"Cockle", new(cockleCD).HasZeroDivisors, true},
+		{"Supra", new(supraCD).HasZeroDivisors, true},
+	}
+	for _, test := range tests {
+		if got := test.has(1); got != test.want {
+			t.Errorf("%s: HasZeroDivisors(1) = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
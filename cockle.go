@@ -23,16 +23,38 @@ func (z *Cockle) Cartesian() (*big.Int, *big.Int, *big.Int, *big.Int) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// Components returns the four Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *Cockle) Components() []*big.Int {
+	a, b, c, d := z.Cartesian()
+	return []*big.Int{a, b, c, d}
+}
+
+// Unreal returns the vector part of z, that is, a copy of z with the real part
+// set to zero.
+func (z *Cockle) Unreal() *Cockle {
+	v := new(Cockle).Set(z)
+	v.l.l.SetInt64(0)
+	return v
+}
+
 // String returns the string representation of a Cockle value.
 // If z corresponds to a + bi + ct + du, then the string is "(a+bi+ct+du)",
 // similar to complex128 values.
 func (z *Cockle) String() string {
+	return z.StringWith(symbCockle)
+}
+
+// StringWith returns the string representation of z with the units written as
+// symb, where symb[0] is the unit of the real part. The layout is the same as
+// that of String.
+func (z *Cockle) StringWith(symb [4]string) string {
 	v := make([]*big.Int, 4)
 	v[0], v[1] = z.l.Cartesian()
 	v[2], v[3] = z.r.Cartesian()
 	a := make([]string, 9)
 	a[0] = "("
-	a[1] = fmt.Sprintf("%v", v[0])
+	a[1] = fmt.Sprintf("%v%s", v[0], symb[0])
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
 		if v[i].Sign() == -1 {
@@ -40,13 +62,23 @@ func (z *Cockle) String() string {
 		} else {
 			a[j] = fmt.Sprintf("+%v", v[i])
 		}
-		a[j+1] = symbCockle[i]
+		a[j+1] = symb[i]
 		i++
 	}
 	a[8] = ")"
 	return strings.Join(a, "")
 }
 
+// Compact returns a compact string representation of z. It is like String, but
+// zero terms are omitted and unit coefficients are collapsed, so that the
+// string is "(1)" or "(1-u)" instead of listing every component. The zero
+// value gives "(0)".
+func (z *Cockle) Compact() string {
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return "(" + compactTerms(v, symbCockle[:]) + ")"
+}
+
 // Equals returns true if y and z are equal.
 func (z *Cockle) Equals(y *Cockle) bool {
 	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
@@ -55,6 +87,17 @@ func (z *Cockle) Equals(y *Cockle) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Cockle) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Complex))
+}
+
+// IsUnreal returns true if the real part of z is zero and at least one of the
+// other components is not, so that z is a nonzero pure unreal value.
+func (z *Cockle) IsUnreal() bool {
+	return z.l.l.Sign() == 0 && !z.IsReal()
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Cockle) Set(y *Cockle) *Cockle {
 	z.l.Set(&y.l)
@@ -62,6 +105,31 @@ func (z *Cockle) Set(y *Cockle) *Cockle {
 	return z
 }
 
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *Cockle) Clone() *Cockle {
+	return new(Cockle).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *Cockle) IsZero() bool {
+	return z.l.IsZero() && z.r.IsZero()
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *Cockle) SetZero() *Cockle {
+	z.l.SetZero()
+	z.r.SetZero()
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *Cockle) SetOne() *Cockle {
+	z.l.SetOne()
+	z.r.SetZero()
+	return z
+}
+
 // NewCockle returns a pointer to the Cockle value a+bi+ct+du.
 func NewCockle(a, b, c, d *big.Int) *Cockle {
 	z := new(Cockle)
@@ -72,6 +140,24 @@ func NewCockle(a, b, c, d *big.Int) *Cockle {
 	return z
 }
 
+// FromComplex sets z equal to the Gaussian integer x, with zero t and u
+// components, and returns z. Although Quad for Cockle is indefinite, it agrees
+// with Quad for Complex on the image, so every nonzero image is timelike and
+// none is a zero divisor.
+func (z *Cockle) FromComplex(x *Complex) *Cockle {
+	z.l.Set(x)
+	z.r.SetZero()
+	return z
+}
+
+// ToComplex returns a pointer to a new Complex value holding the real and i
+// components of z, and true if the t and u components of z are zero. Otherwise
+// the projection is still returned, but with false; its Quad then differs from
+// that of z by Mul(c, c) + Mul(d, d).
+func (z *Cockle) ToComplex() (*Complex, bool) {
+	return new(Complex).Set(&z.l), z.r.IsZero()
+}
+
 // Scal sets z equal to y scaled by a, and returns z.
 func (z *Cockle) Scal(y *Cockle, a *big.Int) *Cockle {
 	z.l.Scal(&y.l, a)
@@ -107,6 +193,16 @@ func (z *Cockle) Sub(x, y *Cockle) *Cockle {
 	return z
 }
 
+// Sum sets z equal to the sum of xs, and returns z. If xs is empty, then z is
+// zero.
+func (z *Cockle) Sum(xs ...*Cockle) *Cockle {
+	s := new(Cockle)
+	for _, x := range xs {
+		s.Add(s, x)
+	}
+	return z.Set(s)
+}
+
 // Mul sets z equal to the product of x and y, and returns z.
 //
 // The multiplication rules are:
@@ -141,6 +237,22 @@ func (z *Cockle) Commutator(x, y *Cockle) *Cockle {
 	)
 }
 
+// CommutatorSpan returns the rank of the integral span of the pairwise
+// commutators of z and the elements of xs. The rank is zero exactly when all of
+// these values commute with each other.
+func (z *Cockle) CommutatorSpan(xs []*Cockle) int {
+	ys := append([]*Cockle{z}, xs...)
+	rows := [][]*big.Int{}
+	for i := 0; i < len(ys); i++ {
+		for j := i + 1; j < len(ys); j++ {
+			c := new(Cockle).Commutator(ys[i], ys[j])
+			a, b, d, e := c.Cartesian()
+			rows = append(rows, []*big.Int{a, b, d, e})
+		}
+	}
+	return rank(rows)
+}
+
 // Quad returns the quadrance of z. If z = a+bi+ct+du, then the quadrance is
 // 		Mul(a, a) + Mul(b, b) - Mul(c, c) - Mul(d, d)
 // This can be positive, negative, or zero.
@@ -151,11 +263,86 @@ func (z *Cockle) Quad() *big.Int {
 	)
 }
 
+// Trace returns the reduced trace of z. If z = a+bi+ct+du, then the trace is
+// 		Add(z, Conj(z)) = Mul(2, a)
+// Together with Quad, it gives the coefficients of the minimal polynomial
+// 		Mul(z, z) - Mul(Trace(z), z) + Quad(z) = 0
+func (z *Cockle) Trace() *big.Int {
+	return new(big.Int).Lsh(&z.l.l, 1)
+}
+
 // IsZeroDiv returns true if z is a zero divisor.
 func (z *Cockle) IsZeroDiv() bool {
 	return z.l.Quad().Cmp((&z.r).Quad()) == 0
 }
 
+// Peirce returns the Peirce decomposition of z relative to the idempotent
+// e = (1+t)/2, whose matrix, as given by Matrix, is [1 0; 0 0]. The first value
+// is the diagonal part
+// 		Mul(e, z, e) + Mul(1-e, z, 1-e) = a+ct
+// and the second value is the off-diagonal part
+// 		Mul(e, z, 1-e) + Mul(1-e, z, e) = bi+du
+// where z = a+bi+ct+du. Their sum is z. Although e is not integral, both parts
+// are. The diagonal part commutes with t, and the off-diagonal part
+// anticommutes with t.
+func (z *Cockle) Peirce() (*Cockle, *Cockle) {
+	a, b, c, d := z.Cartesian()
+	zero := new(big.Int)
+	return NewCockle(a, zero, c, zero), NewCockle(zero, b, zero, d)
+}
+
+// IsTimelike returns true if Quad(z) is positive.
+func (z *Cockle) IsTimelike() bool {
+	return z.Quad().Sign() > 0
+}
+
+// IsSpacelike returns true if Quad(z) is negative.
+func (z *Cockle) IsSpacelike() bool {
+	return z.Quad().Sign() < 0
+}
+
+// IsLightlike returns true if Quad(z) is zero. This is equivalent to z being a
+// zero divisor.
+func (z *Cockle) IsLightlike() bool {
+	return z.Quad().Sign() == 0
+}
+
+// Matrix returns the 2×2 integral matrix that represents z. The units are sent
+// to
+// 		i = [0 -1; 1 0]    t = [1 0; 0 -1]    u = [0 1; 1 0]
+// so that if z = a+bi+ct+du, then the matrix is
+// 		a+c  d-b
+// 		d+b  a-c
+// This is a ring homomorphism: the matrix of Mul(x, y) is the product of the
+// matrices of x and y. Its determinant is Quad(z).
+func (z *Cockle) Matrix() [2][2]*big.Int {
+	a, b, c, d := z.Cartesian()
+	return [2][2]*big.Int{
+		{new(big.Int).Add(a, c), new(big.Int).Sub(d, b)},
+		{new(big.Int).Add(d, b), new(big.Int).Sub(a, c)},
+	}
+}
+
+// SetMatrix sets z equal to the value whose matrix, as given by Matrix, is m,
+// and returns z and true. Over the integers, the image of Matrix consists of
+// the matrices whose diagonal entries have the same parity and whose
+// off-diagonal entries have the same parity. If m is not of that form, then z
+// is not modified, and SetMatrix returns nil and false.
+func (z *Cockle) SetMatrix(m [2][2]*big.Int) (*Cockle, bool) {
+	a := new(big.Int).Add(m[0][0], m[1][1])
+	c := new(big.Int).Sub(m[0][0], m[1][1])
+	d := new(big.Int).Add(m[1][0], m[0][1])
+	b := new(big.Int).Sub(m[1][0], m[0][1])
+	if a.Bit(0) != 0 || d.Bit(0) != 0 {
+		return nil, false
+	}
+	z.l.l.Rsh(a, 1)
+	z.l.r.Rsh(b, 1)
+	z.r.l.Rsh(c, 1)
+	z.r.r.Rsh(d, 1)
+	return z, true
+}
+
 // Quo sets z equal to the quotient of x and y, and returns z.
 func (z *Cockle) Quo(x, y *Cockle) *Cockle {
 	if y.IsZeroDiv() {
@@ -171,6 +358,51 @@ func (z *Cockle) Quo(x, y *Cockle) *Cockle {
 	return z
 }
 
+// Inv sets z equal to the inverse of y, and returns nil. The inverse of y is
+// 		Conj(y) / Quad(y)
+// so y is invertible over the integers only if Quad(y) divides every
+// component; in particular, every y with Quad(y) equal to ±1 is invertible. If
+// y is a zero divisor, then Inv returns ErrZeroDivisor; if the inverse is not
+// integral, then Inv returns ErrNotIntegral. In both cases z is not modified.
+func (z *Cockle) Inv(y *Cockle) error {
+	if y.IsZeroDiv() {
+		return ErrZeroDivisor
+	}
+	c := new(Cockle).Conj(y)
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = c.Cartesian()
+	if !quoExact(v, y.Quad()) {
+		return ErrNotIntegral
+	}
+	z.Set(c)
+	return nil
+}
+
+// Pow sets z equal to x raised to the nth power, and returns z. Binary
+// exponentiation is used, which is well-defined since multiplication is
+// associative. If n is zero, then z is one. If n is negative, then z is the
+// quotient of one and x raised to the -n power, as given by Quo, so Pow panics
+// if x is a zero divisor.
+func (z *Cockle) Pow(x *Cockle, n int) *Cockle {
+	if n < 0 {
+		p := new(Cockle).Pow(x, -n)
+		zero := new(big.Int)
+		return z.Quo(NewCockle(big.NewInt(1), zero, zero, zero), p)
+	}
+	p := new(Cockle).Set(x)
+	z.Set(new(Cockle))
+	z.l.l.SetInt64(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			z.Mul(z, p)
+		}
+		if n > 1 {
+			p.Mul(p, p)
+		}
+	}
+	return z
+}
+
 // IsNilpotent returns true if z raised to the nth power vanishes.
 func (z *Cockle) IsNilpotent(n int) bool {
 	zero := new(Cockle)
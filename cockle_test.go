@@ -290,3 +290,388 @@ func TestCockleComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Commutator span
+
+func TestCockleCommutatorSpan(t *testing.T) {
+	c := func(a, b, d, e int64) *Cockle {
+		return NewCockle(big.NewInt(a), big.NewInt(b), big.NewInt(d), big.NewInt(e))
+	}
+	var tests = []struct {
+		z    *Cockle
+		xs   []*Cockle
+		want int
+	}{
+		{c(1, 2, 0, 0), []*Cockle{c(3, -1, 0, 0), c(0, 5, 0, 0)}, 0},
+		{c(2, 0, 1, 1), []*Cockle{c(4, 0, 2, 2)}, 0},
+		{c(0, 1, 0, 0), []*Cockle{c(0, 0, 1, 0)}, 1},
+		{c(0, 1, 0, 0), []*Cockle{c(0, 0, 1, 0), c(0, 0, 0, 1)}, 3},
+	}
+	for _, test := range tests {
+		if got := test.z.CommutatorSpan(test.xs); got != test.want {
+			t.Errorf("CommutatorSpan(%v, %v) = %v, want %v", test.z, test.xs, got, test.want)
+		}
+	}
+}
+
+// Powers
+
+func TestCocklePowFourth(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		l := new(Cockle).Pow(x, 4)
+		r := new(Cockle).Mul(x, x)
+		r.Mul(r, x)
+		r.Mul(r, x)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCocklePowNegative(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	var tests = []struct {
+		x    *Cockle
+		n    int
+		want *Cockle
+	}{
+		{NewCockle(zero, zero, one, zero), -1, NewCockle(zero, zero, one, zero)},
+		{NewCockle(zero, one, zero, zero), -1, NewCockle(zero, big.NewInt(-1), zero, zero)},
+		{NewCockle(zero, one, zero, zero), -2, NewCockle(big.NewInt(-1), zero, zero, zero)},
+	}
+	for _, test := range tests {
+		if got := new(Cockle).Pow(test.x, test.n); !got.Equals(test.want) {
+			t.Errorf("Pow(%v, %v) = %v, want %v", test.x, test.n, got, test.want)
+		}
+	}
+}
+
+func TestCocklePowZeroDivPanics(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	x := NewCockle(one, zero, one, zero)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Pow(%v, -1) did not panic", x)
+		}
+	}()
+	new(Cockle).Pow(x, -1)
+}
+
+// Sums
+
+func TestCockleSum(t *testing.T) {
+	f := func(x, y, w *Cockle) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(Cockle).Sum(x, y, w)
+		r := new(Cockle).Add(new(Cockle).Add(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if z := new(Cockle).Sum(); !z.Equals(new(Cockle)) {
+		t.Errorf("Sum() = %v, want zero", z)
+	}
+}
+
+// Inverses
+
+func TestCockleInv(t *testing.T) {
+	c := func(a, b, d, e int64) *Cockle {
+		return NewCockle(big.NewInt(a), big.NewInt(b), big.NewInt(d), big.NewInt(e))
+	}
+	var tests = []struct {
+		y, want *Cockle
+		err     error
+	}{
+		{c(1, 0, 0, 0), c(1, 0, 0, 0), nil},
+		{c(0, 1, 0, 0), c(0, -1, 0, 0), nil},
+		{c(0, 0, 1, 0), c(0, 0, 1, 0), nil},
+		{c(1, 0, 1, 1), c(-1, 0, 1, 1), nil},
+		{c(0, 0, 0, 0), nil, ErrZeroDivisor},
+		{c(1, 0, 1, 0), nil, ErrZeroDivisor},
+		{c(2, 0, 0, 0), nil, ErrNotIntegral},
+		{c(2, 1, 1, 0), nil, ErrNotIntegral},
+	}
+	for _, test := range tests {
+		z := c(7, 7, 7, 7)
+		err := z.Inv(test.y)
+		if err != test.err {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, err, test.err)
+			continue
+		}
+		if err != nil {
+			if !z.Equals(c(7, 7, 7, 7)) {
+				t.Errorf("Inv(%v) modified z to %v", test.y, z)
+			}
+			continue
+		}
+		if !z.Equals(test.want) {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, z, test.want)
+		}
+	}
+}
+
+// Matrices
+
+func TestCockleMatrixDet(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		m := x.Matrix()
+		det := new(big.Int).Mul(m[0][0], m[1][1])
+		det.Sub(det, new(big.Int).Mul(m[0][1], m[1][0]))
+		return det.Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleMatrixMul(t *testing.T) {
+	f := func(x, y *Cockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b := x.Matrix(), y.Matrix()
+		p := new(Cockle).Mul(x, y).Matrix()
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 2; j++ {
+				e := new(big.Int).Mul(a[i][0], b[0][j])
+				e.Add(e, new(big.Int).Mul(a[i][1], b[1][j]))
+				if e.Cmp(p[i][j]) != 0 {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleSetMatrix(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		y, ok := new(Cockle).SetMatrix(x.Matrix())
+		return ok && y.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	n := func(a, b, c, d int64) [2][2]*big.Int {
+		return [2][2]*big.Int{
+			{big.NewInt(a), big.NewInt(b)},
+			{big.NewInt(c), big.NewInt(d)},
+		}
+	}
+	for _, m := range [][2][2]*big.Int{n(1, 0, 0, 0), n(0, 1, 0, 0), n(-3, 2, -1, 2)} {
+		if y, ok := new(Cockle).SetMatrix(m); ok {
+			t.Errorf("SetMatrix(%v) = %v, true, want false", m, y)
+		}
+	}
+}
+
+// Traces
+
+func TestCockleTrace(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		one := new(Cockle).Pow(x, 0)
+		l := new(Cockle).Add(x, new(Cockle).Conj(x))
+		r := new(Cockle).Scal(one, x.Trace())
+		if !l.Equals(r) {
+			return false
+		}
+		// Mul(x, x) - Mul(Trace(x), x) + Quad(x) = 0
+		p := new(Cockle).Mul(x, x)
+		p.Sub(p, new(Cockle).Scal(x, x.Trace()))
+		p.Add(p, one.Scal(one, x.Quad()))
+		return p.Equals(new(Cockle))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Scalar and vector parts
+
+func TestCockleUnreal(t *testing.T) {
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		a := new(big.Int).Rsh(x.Trace(), 1)
+		v := x.Unreal()
+		one := new(Cockle).Pow(x, 0)
+		d := new(Cockle).Sub(x, v)
+		return v.Trace().Sign() == 0 && d.Equals(one.Scal(one, a))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Real and unreal values
+
+func TestCockleIsReal(t *testing.T) {
+	v := func(a, b, c, d int64) *Cockle {
+		return NewCockle(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z            *Cockle
+		real, unreal bool
+	}{
+		{v(0, 0, 0, 0), true, false},
+		{v(3, 0, 0, 0), true, false},
+		{v(0, 0, 0, -2), false, true},
+		{v(0, 5, 0, 0), false, true},
+		{v(1, 0, 0, 1), false, false},
+	}
+	for _, test := range tests {
+		if got := test.z.IsReal(); got != test.real {
+			t.Errorf("IsReal(%v) = %v, want %v", test.z, got, test.real)
+		}
+		if got := test.z.IsUnreal(); got != test.unreal {
+			t.Errorf("IsUnreal(%v) = %v, want %v", test.z, got, test.unreal)
+		}
+	}
+}
+
+// Identities
+
+func TestCockleIdentities(t *testing.T) {
+	f := func(x, y *Cockle) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		zero := new(Cockle).Set(y).SetZero()
+		one := new(Cockle).Set(y).SetOne()
+		if !zero.IsZero() || !zero.Equals(new(Cockle)) || one.IsZero() {
+			return false
+		}
+		if x.IsZero() != x.Equals(zero) {
+			return false
+		}
+		l := new(Cockle).Mul(x, one)
+		r := new(Cockle).Mul(one, x)
+		return new(Cockle).Add(x, zero).Equals(x) && l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Causal character
+
+func TestCockleCausalCharacter(t *testing.T) {
+	c := func(a, b, d, e int64) *Cockle {
+		return NewCockle(big.NewInt(a), big.NewInt(b), big.NewInt(d), big.NewInt(e))
+	}
+	var tests = []struct {
+		z    *Cockle
+		want int
+	}{
+		{c(0, 0, 0, 0), 0},
+		{c(1, 0, 1, 0), 0},
+		{c(3, 4, 0, 5), 0},
+		{c(1, 1, 1, 0), 1},
+		{c(0, 2, 1, 1), 1},
+		{c(0, 0, 0, 1), -1},
+		{c(1, 1, 1, 1), 0},
+		{c(1, 0, 2, 0), -1},
+	}
+	for _, test := range tests {
+		z := test.z
+		if z.IsTimelike() != (test.want > 0) || z.IsSpacelike() != (test.want < 0) || z.IsLightlike() != (test.want == 0) {
+			t.Errorf("%v is misclassified: timelike %v, spacelike %v, lightlike %v",
+				z, z.IsTimelike(), z.IsSpacelike(), z.IsLightlike())
+		}
+	}
+}
+
+func TestCockleLightlikeZeroDiv(t *testing.T) {
+	f := func(a, b, c, d int8) bool {
+		// t.Logf("a = %v, b = %v, c = %v, d = %v", a, b, c, d)
+		z := NewCockle(big.NewInt(int64(a%4)), big.NewInt(int64(b%4)), big.NewInt(int64(c%4)), big.NewInt(int64(d%4)))
+		return z.IsLightlike() == z.IsZeroDiv()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Peirce decomposition
+
+func TestCocklePeirce(t *testing.T) {
+	zero, one := new(big.Int), big.NewInt(1)
+	tUnit := NewCockle(zero, zero, one, zero)
+	f := func(x *Cockle) bool {
+		// t.Logf("x = %v", x)
+		d, o := x.Peirce()
+		if !new(Cockle).Add(d, o).Equals(x) {
+			return false
+		}
+		if !new(Cockle).Commutator(d, tUnit).IsZero() {
+			return false
+		}
+		l := new(Cockle).Mul(o, tUnit)
+		r := new(Cockle).Mul(tUnit, o)
+		if !l.Add(l, r).IsZero() {
+			return false
+		}
+		md, mo := d.Matrix(), o.Matrix()
+		return md[0][1].Sign() == 0 && md[1][0].Sign() == 0 &&
+			mo[0][0].Sign() == 0 && mo[1][1].Sign() == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Embeddings
+
+func TestCockleFromComplex(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b := new(Cockle).FromComplex(x), new(Cockle).FromComplex(y)
+		if z, ok := a.ToComplex(); !ok || !z.Equals(x) {
+			return false
+		}
+		if a.Quad().Cmp(x.Quad()) != 0 || a.IsZeroDiv() != x.IsZero() {
+			return false
+		}
+		if !x.IsZero() && !a.IsTimelike() {
+			return false
+		}
+		prod := new(Cockle).FromComplex(new(Complex).Mul(x, y))
+		return prod.Equals(new(Cockle).Mul(a, b))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCockleToComplex(t *testing.T) {
+	c := func(a, b, d, e int64) *Cockle {
+		return NewCockle(big.NewInt(a), big.NewInt(b), big.NewInt(d), big.NewInt(e))
+	}
+	var tests = []struct {
+		z    *Cockle
+		want *Complex
+		ok   bool
+	}{
+		{c(3, -4, 0, 0), NewComplex(big.NewInt(3), big.NewInt(-4)), true},
+		{c(0, 0, 1, 0), new(Complex), false},
+		{c(1, 0, 1, 0), NewComplex(big.NewInt(1), new(big.Int)), false},
+		{c(2, 1, 0, 3), NewComplex(big.NewInt(2), big.NewInt(1)), false},
+	}
+	for _, test := range tests {
+		got, ok := test.z.ToComplex()
+		if !got.Equals(test.want) || ok != test.ok {
+			t.Errorf("ToComplex(%v) = %v, %v, want %v, %v", test.z, got, ok, test.want, test.ok)
+		}
+		_, _, c, d := test.z.Cartesian()
+		diff := new(big.Int).Sub(got.Quad(), test.z.Quad())
+		want := new(big.Int).Add(new(big.Int).Mul(c, c), new(big.Int).Mul(d, d))
+		if diff.Cmp(want) != 0 {
+			t.Errorf("Quad(ToComplex(%v)) - Quad(%v) = %v, want %v", test.z, test.z, diff, want)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package integral
 
 import (
+	"flag"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -11,6 +12,8 @@ import (
 	"strings"
 )
 
+var symbComplex = [2]string{"", "i"}
+
 // A Complex represents an integral complex number.
 type Complex struct {
 	l, r big.Int
@@ -26,24 +29,101 @@ func (z *Complex) Cartesian() (*big.Int, *big.Int) {
 	return &z.l, &z.r
 }
 
+// Components returns the two Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *Complex) Components() []*big.Int {
+	a, b := z.Cartesian()
+	return []*big.Int{a, b}
+}
+
 // String returns the string version of a Complex value.
 //
 // If z corresponds to a + bi, then the string is "(a+bi)", similar to
 // complex128 values.
 func (z *Complex) String() string {
+	return z.StringWith(symbComplex)
+}
+
+// StringWith returns the string representation of z with the units written as
+// symb, where symb[0] is the unit of the real part. The layout is the same as
+// that of String.
+func (z *Complex) StringWith(symb [2]string) string {
 	a := make([]string, 5)
 	a[0] = "("
-	a[1] = fmt.Sprintf("%v", &z.l)
+	a[1] = fmt.Sprintf("%v%s", &z.l, symb[0])
 	if z.r.Sign() == -1 {
 		a[2] = fmt.Sprintf("%v", &z.r)
 	} else {
 		a[2] = fmt.Sprintf("+%v", &z.r)
 	}
-	a[3] = "i"
+	a[3] = symb[1]
 	a[4] = ")"
 	return strings.Join(a, "")
 }
 
+// Compact returns a compact string representation of z. It is like String, but
+// zero terms are omitted and unit coefficients are collapsed, so that the
+// string is "(1)" or "(1-i)" instead of listing every component. The zero
+// value gives "(0)".
+func (z *Complex) Compact() string {
+	return "(" + compactTerms([]*big.Int{&z.l, &z.r}, symbComplex[:]) + ")"
+}
+
+// Format implements the fmt.Formatter interface. The verbs 'b', 'o', 'O', 'd',
+// 'x', and 'X' format each component of z like a big.Int, with the flags,
+// width, and precision forwarded. The '+' flag forces a sign on the real part;
+// the imaginary part always has a sign. Any other verb gives the same result
+// as String.
+func (z *Complex) Format(s fmt.State, ch rune) {
+	switch ch {
+	case 'b', 'o', 'O', 'd', 'x', 'X':
+		fmt.Fprint(s, formatTerms(s, ch, []*big.Int{&z.l, &z.r}, symbComplex[:]))
+	default:
+		fmt.Fprint(s, z.String())
+	}
+}
+
+// SetString sets z equal to the value of s, and returns z and a boolean
+// indicating success. The string s must be of the form "(a+bi)", where omitted
+// terms are zero. If SetString fails, the value of z is undefined but the
+// returned value is nil.
+func (z *Complex) SetString(s string) (*Complex, bool) {
+	v, ok := parseTerms(s, symbComplex[:])
+	if !ok {
+		return nil, false
+	}
+	z.l.Set(v[0])
+	z.r.Set(v[1])
+	return z, true
+}
+
+// Flag returns a flag.Value that parses its argument into z.
+func (z *Complex) Flag() flag.Value {
+	return complexValue{z}
+}
+
+// complexValue adapts a *Complex to the flag.Value interface, since the Set
+// method of Complex is already taken.
+type complexValue struct {
+	z *Complex
+}
+
+func (v complexValue) String() string {
+	if v.z == nil {
+		return ""
+	}
+	return v.z.String()
+}
+
+func (v complexValue) Set(s string) error {
+	y, ok := new(Complex).SetString(s)
+	if !ok {
+		return fmt.Errorf("invalid Complex value %q", s)
+	}
+	v.z.Set(y)
+	return nil
+}
+
 // Equals returns true if y and z are equal.
 func (z *Complex) Equals(y *Complex) bool {
 	if z.l.Cmp(&y.l) != 0 || z.r.Cmp(&y.r) != 0 {
@@ -52,6 +132,17 @@ func (z *Complex) Equals(y *Complex) bool {
 	return true
 }
 
+// IsReal returns true if the non-real component of z is zero.
+func (z *Complex) IsReal() bool {
+	return z.r.Sign() == 0
+}
+
+// IsUnreal returns true if the real part of z is zero and the other component
+// is not, so that z is a nonzero pure unreal value.
+func (z *Complex) IsUnreal() bool {
+	return z.l.Sign() == 0 && z.r.Sign() != 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Complex) Set(y *Complex) *Complex {
 	z.l.Set(&y.l)
@@ -59,6 +150,31 @@ func (z *Complex) Set(y *Complex) *Complex {
 	return z
 }
 
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *Complex) Clone() *Complex {
+	return new(Complex).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *Complex) IsZero() bool {
+	return z.l.Sign() == 0 && z.r.Sign() == 0
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *Complex) SetZero() *Complex {
+	z.l.SetInt64(0)
+	z.r.SetInt64(0)
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *Complex) SetOne() *Complex {
+	z.l.SetInt64(1)
+	z.r.SetInt64(0)
+	return z
+}
+
 // NewComplex returns a pointer to the Complex value a+bi.
 func NewComplex(a, b *big.Int) *Complex {
 	z := new(Complex)
@@ -102,6 +218,16 @@ func (z *Complex) Sub(x, y *Complex) *Complex {
 	return z
 }
 
+// Sum sets z equal to the sum of xs, and returns z. If xs is empty, then z is
+// zero.
+func (z *Complex) Sum(xs ...*Complex) *Complex {
+	s := new(Complex)
+	for _, x := range xs {
+		s.Add(s, x)
+	}
+	return z.Set(s)
+}
+
 // Mul sets z equal to the product of x and y, and returns z.
 //
 // The multiplication rule is:
@@ -135,6 +261,71 @@ func (z *Complex) Quad() *big.Int {
 	)
 }
 
+// Trace returns the reduced trace of z. If z = a+bi, then the trace is
+// 		Add(z, Conj(z)) = Mul(2, a)
+// Together with Quad, it gives the coefficients of the minimal polynomial
+// 		Mul(z, z) - Mul(Trace(z), z) + Quad(z) = 0
+func (z *Complex) Trace() *big.Int {
+	return new(big.Int).Lsh(&z.l, 1)
+}
+
+// Dot returns the Euclidean inner product of z and y. If z = a+bi and y = c+di,
+// then the inner product is the real part of Mul(z, Conj(y)):
+// 		Mul(a, c) + Mul(b, d)
+// Note that Dot(z, z) is the quadrance of z.
+func (z *Complex) Dot(y *Complex) *big.Int {
+	dot := new(big.Int).Mul(&z.l, &y.l)
+	return dot.Add(dot, new(big.Int).Mul(&z.r, &y.r))
+}
+
+// Norm returns the Euclidean norm of z, that is, the square root of Quad(z),
+// computed with prec bits of mantissa. The result is exact only if Quad(z) is a
+// perfect square that fits in prec bits; otherwise it is rounded to nearest
+// even, as by big.Float.Sqrt. If prec is zero, then the precision is that
+// needed to hold Quad(z) exactly.
+func (z *Complex) Norm(prec uint) *big.Float {
+	norm := new(big.Float).SetPrec(prec).SetInt(z.Quad())
+	return norm.Sqrt(norm)
+}
+
+// Complex128 returns the complex128 value nearest to z. Each component is
+// rounded to the nearest float64 independently, so components beyond 2⁵³ in
+// magnitude may lose precision, and components too large for a float64
+// become ±Inf.
+func (z *Complex) Complex128() complex128 {
+	return complex(float64Of(&z.l), float64Of(&z.r))
+}
+
+// float64Of returns the float64 value nearest to x, or ±Inf if x overflows.
+func float64Of(x *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(x).Float64()
+	return f
+}
+
+// Matrix returns the 2×2 integral matrix of multiplication by z. If z = a+bi,
+// then the matrix is
+// 		a  -b
+// 		b   a
+// Its determinant is Quad(z).
+func (z *Complex) Matrix() [2][2]*big.Int {
+	return [2][2]*big.Int{
+		{new(big.Int).Set(&z.l), new(big.Int).Neg(&z.r)},
+		{new(big.Int).Set(&z.r), new(big.Int).Set(&z.l)},
+	}
+}
+
+// SetMatrix sets z equal to the value whose matrix, as given by Matrix, is m,
+// and returns z and true. If m is not of that form, then z is not modified,
+// and SetMatrix returns nil and false.
+func (z *Complex) SetMatrix(m [2][2]*big.Int) (*Complex, bool) {
+	if m[0][0].Cmp(m[1][1]) != 0 || new(big.Int).Neg(m[0][1]).Cmp(m[1][0]) != 0 {
+		return nil, false
+	}
+	z.l.Set(m[0][0])
+	z.r.Set(m[1][0])
+	return z, true
+}
+
 // Quo sets z equal to the quotient of x and y, and returns z. Note that
 // truncated division is used.
 func (z *Complex) Quo(x, y *Complex) *Complex {
@@ -149,6 +340,267 @@ func (z *Complex) Quo(x, y *Complex) *Complex {
 	return z
 }
 
+// Inv sets z equal to the inverse of y, and returns nil. The inverse of y is
+// 		Conj(y) / Quad(y)
+// which is integral only if y is a unit (±1 or ±i). If y is zero, then Inv
+// returns ErrZeroDivisor; if the inverse is not integral, then Inv returns
+// ErrNotIntegral. In both cases z is not modified.
+func (z *Complex) Inv(y *Complex) error {
+	if zero := new(Complex); y.Equals(zero) {
+		return ErrZeroDivisor
+	}
+	c := new(Complex).Conj(y)
+	if !quoExact([]*big.Int{&c.l, &c.r}, y.Quad()) {
+		return ErrNotIntegral
+	}
+	z.Set(c)
+	return nil
+}
+
+// ExactQuo sets z equal to the quotient of x and y, and returns z and true, if
+// y divides x exactly, so that Mul(y, z) = x. Otherwise z is not modified, and
+// ExactQuo returns nil and false. If y is zero, then ExactQuo panics.
+func (z *Complex) ExactQuo(x, y *Complex) (*Complex, bool) {
+	if zero := new(Complex); y.Equals(zero) {
+		panic("zero denominator")
+	}
+	p := new(Complex).Conj(y)
+	p.Mul(x, p)
+	if !quoExact([]*big.Int{&p.l, &p.r}, y.Quad()) {
+		return nil, false
+	}
+	return z.Set(p), true
+}
+
+// Pow sets z equal to x raised to the nth power, and returns z. Binary
+// exponentiation is used. If n is zero, then z is one. If n is negative, then z
+// is the quotient of one and x raised to the -n power, as given by Quo, so Pow
+// panics if x is zero.
+func (z *Complex) Pow(x *Complex, n int) *Complex {
+	if n < 0 {
+		p := new(Complex).Pow(x, -n)
+		return z.Quo(NewComplex(big.NewInt(1), new(big.Int)), p)
+	}
+	p := new(Complex).Set(x)
+	z.l.SetInt64(1)
+	z.r.SetInt64(0)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			z.Mul(z, p)
+		}
+		if n > 1 {
+			p.Mul(p, p)
+		}
+	}
+	return z
+}
+
+// GeneratesField returns a label for the field generated by z over the
+// rationals. If z = a+bi, then the discriminant of its minimal polynomial is
+// 		Mul(Trace(z), Trace(z)) - Mul(4, Quad(z)) = Mul(-4, b, b)
+// whose squarefree part is -1 whenever b is nonzero. So the label is "Q(i)"
+// for every non-real z, and "Q" for real z.
+func (z *Complex) GeneratesField() string {
+	if z.r.Sign() == 0 {
+		return "Q"
+	}
+	return "Q(i)"
+}
+
+// quoRound sets z equal to the quotient of x and y, with each component rounded
+// to the nearest integer, and returns z. Halves are rounded up. If y is zero,
+// then quoRound panics.
+func (z *Complex) quoRound(x, y *Complex) *Complex {
+	if zero := new(Complex); y.Equals(zero) {
+		panic("zero denominator")
+	}
+	quad := y.Quad()
+	twice := new(big.Int).Lsh(quad, 1)
+	z.Conj(y)
+	z.Mul(x, z)
+	z.l.Div(z.l.Add(z.l.Lsh(&z.l, 1), quad), twice)
+	z.r.Div(z.r.Add(z.r.Lsh(&z.r, 1), quad), twice)
+	return z
+}
+
+// QuoRem sets z equal to the quotient of x and y, with each component of
+// 		Mul(x, Conj(y)) / Quad(y)
+// rounded to the nearest integer, and sets r equal to the remainder
+// 		Sub(x, Mul(y, z))
+// Then it returns z and r. Since each component is off by at most one half,
+// Quad(r) is at most half of Quad(y), so it is strictly smaller. If y is zero,
+// then QuoRem panics.
+func (z *Complex) QuoRem(x, y, r *Complex) (*Complex, *Complex) {
+	a := new(Complex).Set(x)
+	b := new(Complex).Set(y)
+	z.quoRound(a, b)
+	r.Sub(a, r.Mul(b, z))
+	return z, r
+}
+
+// Mod sets z equal to the remainder of x and y, as given by QuoRem, and
+// returns z. Hence Quad(z) is strictly smaller than Quad(y). If y is zero, then
+// Mod panics.
+func (z *Complex) Mod(x, y *Complex) *Complex {
+	new(Complex).QuoRem(x, y, z)
+	return z
+}
+
+// GCD sets z equal to a greatest common divisor of x and y, and returns z. The
+// Euclidean algorithm is used with nearest-integer division. The result is
+// unique up to a unit, and it is normalized to have positive real part and
+// non-negative imaginary part. If y is zero, then z is the normalized x. If
+// both x and y are zero, then z is zero.
+func (z *Complex) GCD(x, y *Complex) *Complex {
+	a := new(Complex).Set(x)
+	b := new(Complex).Set(y)
+	zero := new(Complex)
+	q, temp := new(Complex), new(Complex)
+	for !b.Equals(zero) {
+		q.QuoRem(a, b, temp)
+		a, b, temp = b, temp, a
+	}
+	return z.normalize(a)
+}
+
+// normalize sets z equal to the associate of y with positive real part and
+// non-negative imaginary part, and returns z. If y is zero, then so is z.
+func (z *Complex) normalize(y *Complex) *Complex {
+	z.Set(y)
+	temp := new(big.Int)
+	for i := 0; i < 4 && !(z.l.Sign() > 0 && z.r.Sign() >= 0); i++ {
+		// Multiply by i.
+		temp.Neg(&z.r)
+		z.r.Set(&z.l)
+		z.l.Set(temp)
+	}
+	return z
+}
+
+// IsPrime returns true if z is a Gaussian prime. If z = a+bi, where exactly
+// one of a and b is zero, then z is prime if the absolute value of the other
+// component is a rational prime congruent to 3 modulo 4. If both a and b are
+// nonzero, then z is prime if Quad(z) is a rational prime. Zero is not prime.
+// The rational primality checks use ProbablyPrime, which is exact for values
+// less than 2⁶⁴.
+func (z *Complex) IsPrime() bool {
+	const reps = 20
+	switch {
+	case z.l.Sign() == 0 && z.r.Sign() == 0:
+		return false
+	case z.l.Sign() == 0 || z.r.Sign() == 0:
+		p := new(big.Int).Abs(&z.l)
+		if z.l.Sign() == 0 {
+			p.Abs(&z.r)
+		}
+		return p.Bit(0) == 1 && p.Bit(1) == 1 && p.ProbablyPrime(reps)
+	default:
+		return z.Quad().ProbablyPrime(reps)
+	}
+}
+
+// Factorize returns the Gaussian primes, with multiplicity, whose product is an
+// associate of z. The primes are found by factoring Quad(z) into rational
+// primes with trial division, so Factorize is only practical when Quad(z) has
+// no large prime factors. Each rational prime p is then lifted as follows:
+// 		p = 2:          1+i, which ramifies
+// 		p ≡ 3 (mod 4):  p itself, which is inert
+// 		p ≡ 1 (mod 4):  one of the two conjugate primes of quadrance p
+// The factors are ordered by the rational prime that they divide. If z is zero
+// or a unit, then the result is empty.
+func (z *Complex) Factorize() []*Complex {
+	var factors []*Complex
+	if zero := new(Complex); z.Equals(zero) {
+		return factors
+	}
+	w := new(Complex).Set(z)
+	q, r := new(Complex), new(Complex)
+	// divide replaces w with the quotient of w and f, and returns true, if f
+	// divides w exactly.
+	divide := func(f *Complex) bool {
+		if q.QuoRem(w, f, r); r.Equals(new(Complex)) {
+			w.Set(q)
+			return true
+		}
+		return false
+	}
+	one, four := big.NewInt(1), big.NewInt(4)
+	n := w.Quad()
+	m := new(big.Int)
+	for p := big.NewInt(2); n.Cmp(one) > 0; p.Add(p, one) {
+		if m.Mul(p, p).Cmp(n) > 0 {
+			// The remaining cofactor is prime.
+			p.Set(n)
+		}
+		e := 0
+		for m.Mod(n, p).Sign() == 0 {
+			n.Quo(n, p)
+			e++
+		}
+		if e == 0 {
+			continue
+		}
+		switch m.Mod(p, four).Int64() {
+		case 2:
+			f := NewComplex(one, one)
+			for ; e > 0; e-- {
+				divide(f)
+				factors = append(factors, new(Complex).Set(f))
+			}
+		case 3:
+			f := NewComplex(p, new(big.Int))
+			for ; e > 0; e -= 2 {
+				divide(f)
+				factors = append(factors, new(Complex).Set(f))
+			}
+		case 1:
+			f := splitPrime(p)
+			g := new(Complex).Conj(f)
+			for ; e > 0; e-- {
+				if divide(f) {
+					factors = append(factors, new(Complex).Set(f))
+				} else {
+					divide(g)
+					factors = append(factors, new(Complex).Set(g))
+				}
+			}
+		}
+	}
+	return factors
+}
+
+// splitPrime returns a Gaussian prime of quadrance p, where p is a rational
+// prime congruent to 1 modulo 4. If x is a square root of -1 modulo p, then the
+// prime is the greatest common divisor of p and x+i.
+func splitPrime(p *big.Int) *Complex {
+	one := big.NewInt(1)
+	pm1 := new(big.Int).Sub(p, one)
+	half := new(big.Int).Rsh(pm1, 1)
+	quarter := new(big.Int).Rsh(pm1, 2)
+	x := new(big.Int)
+	for c := big.NewInt(2); ; c.Add(c, one) {
+		// A quadratic non-residue c gives Pow(c, (p-1)/2) = -1, so that
+		// Pow(c, (p-1)/4) is a square root of -1.
+		if x.Exp(c, half, p).Cmp(pm1) == 0 {
+			x.Exp(c, quarter, p)
+			break
+		}
+	}
+	return new(Complex).GCD(NewComplex(p, new(big.Int)), NewComplex(x, one))
+}
+
+// ContentGCD returns a greatest common divisor of the elements of xs, that is,
+// a generator of the ideal that they span. It is obtained by folding GCD over
+// xs, so it is normalized in the same way. If xs is empty, then ContentGCD
+// returns zero.
+func ContentGCD(xs []*Complex) *Complex {
+	z := new(Complex)
+	for _, x := range xs {
+		z.GCD(z, x)
+	}
+	return z
+}
+
 // Generate returns a random Complex value for quick.Check testing.
 func (z *Complex) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomComplex := &Complex{
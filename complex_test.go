@@ -4,6 +4,8 @@
 package integral
 
 import (
+	"fmt"
+	"math"
 	"math/big"
 	"testing"
 	"testing/quick"
@@ -299,3 +301,634 @@ func TestComplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Parsing
+
+func TestComplexStringSetString(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		l, ok := new(Complex).SetString(x.String())
+		return ok && l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Greatest common divisor
+
+func TestContentGCD(t *testing.T) {
+	c := func(a, b int64) *Complex {
+		return NewComplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		xs   []*Complex
+		want *Complex
+	}{
+		{nil, c(0, 0)},
+		{[]*Complex{c(0, 0)}, c(0, 0)},
+		{[]*Complex{c(-3, 0)}, c(3, 0)},
+		{[]*Complex{c(0, -2)}, c(2, 0)},
+		{[]*Complex{c(2, 0), c(1, 1)}, c(1, 1)},
+		{[]*Complex{c(2, 4), c(6, 0), c(0, 10)}, c(2, 0)},
+		{[]*Complex{c(5, 0), c(3, 4)}, c(2, 1)},
+		{[]*Complex{c(3, 0), c(5, 0), c(1, 1)}, c(1, 0)},
+	}
+	for _, test := range tests {
+		if got := ContentGCD(test.xs); !got.Equals(test.want) {
+			t.Errorf("ContentGCD(%v) = %v, want %v", test.xs, got, test.want)
+		}
+	}
+}
+
+func TestContentGCDDivides(t *testing.T) {
+	f := func(x, y, z, w *Complex) bool {
+		// t.Logf("x = %v, y = %v, z = %v, w = %v", x, y, z, w)
+		xs := []*Complex{
+			new(Complex).Mul(x, w),
+			new(Complex).Mul(y, w),
+			new(Complex).Mul(z, w),
+		}
+		g := ContentGCD(xs)
+		if new(Complex).GCD(g, w).Quad().Cmp(w.Quad()) != 0 {
+			return false
+		}
+		zero := new(Complex)
+		for _, x := range xs {
+			q := new(Complex).quoRound(x, g)
+			if !new(Complex).Sub(x, q.Mul(q, g)).Equals(zero) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexGCD(t *testing.T) {
+	c := func(a, b int64) *Complex {
+		return NewComplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		x, y, want *Complex
+	}{
+		{c(0, 0), c(0, 0), c(0, 0)},
+		{c(3, 4), c(0, 0), c(3, 4)},
+		{c(0, 0), c(-4, 3), c(3, 4)},
+		{c(0, 0), c(0, -5), c(5, 0)},
+		{c(4, 0), c(2, 2), c(2, 2)},
+		{c(13, 0), c(5, 1), c(2, 3)},
+		{c(7, 0), c(5, 1), c(1, 0)},
+		{c(12, -3), c(-3, 9), c(3, 0)},
+		{c(5, 0), c(-1, 2), c(2, 1)},
+	}
+	for _, test := range tests {
+		if got := new(Complex).GCD(test.x, test.y); !got.Equals(test.want) {
+			t.Errorf("GCD(%v, %v) = %v, want %v", test.x, test.y, got, test.want)
+		}
+	}
+}
+
+func TestComplexGCDNormalized(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		g := new(Complex).GCD(x, y)
+		if g.l.Sign() <= 0 || g.r.Sign() < 0 {
+			return false
+		}
+		zero := new(Complex)
+		return new(Complex).Mod(x, g).Equals(zero) && new(Complex).Mod(y, g).Equals(zero)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Formatting
+
+func TestComplexFormat(t *testing.T) {
+	z := NewComplex(big.NewInt(255), big.NewInt(-16))
+	var tests = []struct {
+		format, want string
+	}{
+		{"%v", "(255-16i)"},
+		{"%s", "(255-16i)"},
+		{"%d", "(255-16i)"},
+		{"%+d", "(+255-16i)"},
+		{"%x", "(ff-10i)"},
+		{"%X", "(FF-10i)"},
+		{"%#x", "(0xff-0x10i)"},
+		{"%5d", "(  255  -16i)"},
+		{"%05d", "(00255-0016i)"},
+		{"%b", "(11111111-10000i)"},
+	}
+	for _, test := range tests {
+		if got := fmt.Sprintf(test.format, z); got != test.want {
+			t.Errorf("Sprintf(%q, %v) = %q, want %q", test.format, z, got, test.want)
+		}
+	}
+}
+
+// Powers
+
+func TestComplexPowSquare(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		l := new(Complex).Pow(x, 2)
+		r := new(Complex).Mul(x, x)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexPowAdd(t *testing.T) {
+	f := func(x *Complex, m, n uint8) bool {
+		// t.Logf("x = %v, m = %v, n = %v", x, m, n)
+		l := new(Complex).Pow(x, int(m)+int(n))
+		r := new(Complex).Mul(new(Complex).Pow(x, int(m)), new(Complex).Pow(x, int(n)))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexPow(t *testing.T) {
+	c := func(a, b int64) *Complex {
+		return NewComplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		x    *Complex
+		n    int
+		want *Complex
+	}{
+		{c(3, 4), 0, c(1, 0)},
+		{c(0, 0), 0, c(1, 0)},
+		{c(1, 1), 8, c(16, 0)},
+		{c(0, 1), -1, c(0, -1)},
+		{c(0, 1), -3, c(0, 1)},
+		{c(-1, 0), -5, c(-1, 0)},
+	}
+	for _, test := range tests {
+		if got := new(Complex).Pow(test.x, test.n); !got.Equals(test.want) {
+			t.Errorf("Pow(%v, %v) = %v, want %v", test.x, test.n, got, test.want)
+		}
+	}
+}
+
+// Fields
+
+func TestComplexGeneratesField(t *testing.T) {
+	var tests = []struct {
+		z    *Complex
+		want string
+	}{
+		{NewComplex(big.NewInt(1), big.NewInt(1)), "Q(i)"},
+		{NewComplex(big.NewInt(1), big.NewInt(2)), "Q(i)"},
+		{NewComplex(big.NewInt(1), big.NewInt(3)), "Q(i)"},
+		{NewComplex(big.NewInt(0), big.NewInt(-5)), "Q(i)"},
+		{NewComplex(big.NewInt(5), big.NewInt(0)), "Q"},
+		{new(Complex), "Q"},
+	}
+	for _, test := range tests {
+		if got := test.z.GeneratesField(); got != test.want {
+			t.Errorf("GeneratesField(%v) = %q, want %q", test.z, got, test.want)
+		}
+	}
+}
+
+// Sums
+
+func TestComplexSum(t *testing.T) {
+	f := func(x, y, w *Complex) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(Complex).Sum(x, y, w)
+		r := new(Complex).Add(new(Complex).Add(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if z := new(Complex).Sum(); !z.Equals(new(Complex)) {
+		t.Errorf("Sum() = %v, want zero", z)
+	}
+}
+
+// Inverses
+
+func TestComplexInv(t *testing.T) {
+	c := func(a, b int64) *Complex {
+		return NewComplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		y, want *Complex
+		err     error
+	}{
+		{c(1, 0), c(1, 0), nil},
+		{c(-1, 0), c(-1, 0), nil},
+		{c(0, 1), c(0, -1), nil},
+		{c(0, -1), c(0, 1), nil},
+		{c(0, 0), nil, ErrZeroDivisor},
+		{c(2, 0), nil, ErrNotIntegral},
+		{c(1, 1), nil, ErrNotIntegral},
+	}
+	for _, test := range tests {
+		z := c(7, 7)
+		err := z.Inv(test.y)
+		if err != test.err {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, err, test.err)
+			continue
+		}
+		if err != nil {
+			if !z.Equals(c(7, 7)) {
+				t.Errorf("Inv(%v) modified z to %v", test.y, z)
+			}
+			continue
+		}
+		if !z.Equals(test.want) {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, z, test.want)
+		}
+	}
+}
+
+// Division
+
+func TestComplexQuoRem(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if zero := new(Complex); y.Equals(zero) {
+			return true
+		}
+		q, r := new(Complex).QuoRem(x, y, new(Complex))
+		l := new(Complex).Add(new(Complex).Mul(y, q), r)
+		return l.Equals(x) && r.Quad().Cmp(y.Quad()) < 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexQuoRemNearest(t *testing.T) {
+	c := func(a, b int64) *Complex {
+		return NewComplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		x, y, q, r *Complex
+	}{
+		{c(7, 0), c(2, 0), c(4, 0), c(-1, 0)},
+		{c(-7, 0), c(2, 0), c(-3, 0), c(-1, 0)},
+		{c(5, 3), c(1, 1), c(4, -1), c(0, 0)},
+		{c(11, 4), c(3, -2), c(2, 3), c(-1, -1)},
+	}
+	for _, test := range tests {
+		q, r := new(Complex).QuoRem(test.x, test.y, new(Complex))
+		if !q.Equals(test.q) || !r.Equals(test.r) {
+			t.Errorf("QuoRem(%v, %v) = %v, %v, want %v, %v", test.x, test.y, q, r, test.q, test.r)
+		}
+	}
+}
+
+func TestComplexModQuoRem(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if zero := new(Complex); y.Equals(zero) {
+			return true
+		}
+		_, r := new(Complex).QuoRem(x, y, new(Complex))
+		return new(Complex).Mod(x, y).Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexModZero(t *testing.T) {
+	x := NewComplex(big.NewInt(1), big.NewInt(2))
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Mod(%v, (0+0i)) did not panic", x)
+		}
+	}()
+	new(Complex).Mod(x, new(Complex))
+}
+
+// Primes
+
+func TestComplexIsPrime(t *testing.T) {
+	c := func(a, b int64) *Complex {
+		return NewComplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		z    *Complex
+		want bool
+	}{
+		{c(0, 0), false},
+		{c(1, 0), false},
+		{c(0, -1), false},
+		{c(1, 1), true},
+		{c(-1, 1), true},
+		{c(2, 0), false},
+		{c(3, 0), true},
+		{c(0, -7), true},
+		{c(5, 0), false},
+		{c(9, 0), false},
+		{c(2, 3), true},
+		{c(2, 1), true},
+		{c(3, 3), false},
+		{c(4, 1), true},
+	}
+	for _, test := range tests {
+		if got := test.z.IsPrime(); got != test.want {
+			t.Errorf("IsPrime(%v) = %v, want %v", test.z, got, test.want)
+		}
+	}
+}
+
+func TestComplexFactorize(t *testing.T) {
+	f := func(a, b int16) bool {
+		// t.Logf("a = %v, b = %v", a, b)
+		z := NewComplex(big.NewInt(int64(a)), big.NewInt(int64(b)))
+		factors := z.Factorize()
+		if zero := new(Complex); z.Equals(zero) {
+			return len(factors) == 0
+		}
+		p := NewComplex(big.NewInt(1), new(big.Int))
+		for _, f := range factors {
+			if !f.IsPrime() {
+				return false
+			}
+			p.Mul(p, f)
+		}
+		// p and z are associates if they have the same normalization.
+		return new(Complex).normalize(p).Equals(new(Complex).normalize(z))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexFactorizeKnown(t *testing.T) {
+	c := func(a, b int64) *Complex {
+		return NewComplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		z    *Complex
+		want []*Complex
+	}{
+		{c(0, 0), nil},
+		{c(0, -1), nil},
+		{c(2, 0), []*Complex{c(1, 1), c(1, 1)}},
+		{c(9, 0), []*Complex{c(3, 0), c(3, 0)}},
+		{c(0, 3), []*Complex{c(3, 0)}},
+		{c(3, 4), []*Complex{c(2, 1), c(2, 1)}},
+		{c(5, 0), []*Complex{c(2, 1), c(2, -1)}},
+	}
+	for _, test := range tests {
+		got := test.z.Factorize()
+		if len(got) != len(test.want) {
+			t.Errorf("Factorize(%v) = %v, want %v", test.z, got, test.want)
+			continue
+		}
+		for i := range got {
+			if !got[i].Equals(test.want[i]) {
+				t.Errorf("Factorize(%v) = %v, want %v", test.z, got, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestComplexExactQuo(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if zero := new(Complex); y.Equals(zero) {
+			return true
+		}
+		p := new(Complex).Mul(x, y)
+		q, ok := new(Complex).ExactQuo(p, y)
+		return ok && q.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	c := func(a, b int64) *Complex {
+		return NewComplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		x, y *Complex
+	}{
+		{c(1, 0), c(2, 0)},
+		{c(5, 3), c(2, 1)},
+		{c(3, 0), c(1, 1)},
+	}
+	for _, test := range tests {
+		z := c(7, 7)
+		if q, ok := z.ExactQuo(test.x, test.y); ok || q != nil || !z.Equals(c(7, 7)) {
+			t.Errorf("ExactQuo(%v, %v) = %v, %v, want nil, false", test.x, test.y, q, ok)
+		}
+	}
+}
+
+// Norms
+
+func TestComplexNorm(t *testing.T) {
+	c := func(a, b int64) *Complex {
+		return NewComplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		z    *Complex
+		want int64
+	}{
+		{c(0, 0), 0},
+		{c(3, -4), 5},
+		{c(-5, 12), 13},
+	}
+	for _, test := range tests {
+		if got := test.z.Norm(53); got.Cmp(big.NewFloat(float64(test.want))) != 0 {
+			t.Errorf("Norm(%v, 53) = %v, want %v", test.z, got, test.want)
+		}
+	}
+	z := c(1, 1)
+	for _, prec := range []uint{24, 53, 200} {
+		got := z.Norm(prec)
+		if got.Prec() != prec {
+			t.Errorf("Norm(%v, %v) has precision %v", z, prec, got.Prec())
+		}
+		// The square of the norm is within a few ulps of the quadrance.
+		diff := new(big.Float).Mul(got, got)
+		diff.Sub(diff, big.NewFloat(2))
+		if diff.Sign() != 0 && diff.MantExp(nil) > 4-int(prec) {
+			t.Errorf("Norm(%v, %v) = %v is not close to the square root of 2", z, prec, got)
+		}
+	}
+}
+
+// Inner products
+
+func TestComplexDotQuad(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		return x.Dot(x).Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexDotSymmetric(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p := new(Complex).Mul(x, new(Complex).Conj(y))
+		return x.Dot(y).Cmp(y.Dot(x)) == 0 && x.Dot(y).Cmp(p.Real()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Matrices
+
+func TestComplexMatrixDet(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		m := x.Matrix()
+		det := new(big.Int).Mul(m[0][0], m[1][1])
+		det.Sub(det, new(big.Int).Mul(m[0][1], m[1][0]))
+		return det.Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComplexSetMatrix(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		y, ok := new(Complex).SetMatrix(x.Matrix())
+		return ok && y.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	n := func(a, b, c, d int64) [2][2]*big.Int {
+		return [2][2]*big.Int{
+			{big.NewInt(a), big.NewInt(b)},
+			{big.NewInt(c), big.NewInt(d)},
+		}
+	}
+	for _, m := range [][2][2]*big.Int{n(1, 2, 2, 1), n(1, -2, 2, 3), n(1, 0, 0, -1)} {
+		z := NewComplex(big.NewInt(7), big.NewInt(7))
+		if y, ok := z.SetMatrix(m); ok || y != nil || z.l.Int64() != 7 || z.r.Int64() != 7 {
+			t.Errorf("SetMatrix(%v) = %v, %v, want nil, false", m, y, ok)
+		}
+	}
+}
+
+// Traces
+
+func TestComplexTrace(t *testing.T) {
+	f := func(x *Complex) bool {
+		// t.Logf("x = %v", x)
+		one := new(Complex).Pow(x, 0)
+		l := new(Complex).Add(x, new(Complex).Conj(x))
+		r := new(Complex).Scal(one, x.Trace())
+		if !l.Equals(r) {
+			return false
+		}
+		// Mul(x, x) - Mul(Trace(x), x) + Quad(x) = 0
+		p := new(Complex).Mul(x, x)
+		p.Sub(p, new(Complex).Scal(x, x.Trace()))
+		p.Add(p, one.Scal(one, x.Quad()))
+		return p.Equals(new(Complex))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Real and unreal values
+
+func TestComplexIsReal(t *testing.T) {
+	v := func(a, b int64) *Complex {
+		return NewComplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		z            *Complex
+		real, unreal bool
+	}{
+		{v(0, 0), true, false},
+		{v(3, 0), true, false},
+		{v(0, -2), false, true},
+		{v(0, 5), false, true},
+		{v(1, 1), false, false},
+	}
+	for _, test := range tests {
+		if got := test.z.IsReal(); got != test.real {
+			t.Errorf("IsReal(%v) = %v, want %v", test.z, got, test.real)
+		}
+		if got := test.z.IsUnreal(); got != test.unreal {
+			t.Errorf("IsUnreal(%v) = %v, want %v", test.z, got, test.unreal)
+		}
+	}
+}
+
+// Identities
+
+func TestComplexIdentities(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		zero := new(Complex).Set(y).SetZero()
+		one := new(Complex).Set(y).SetOne()
+		if !zero.IsZero() || !zero.Equals(new(Complex)) || one.IsZero() {
+			return false
+		}
+		if x.IsZero() != x.Equals(zero) {
+			return false
+		}
+		l := new(Complex).Mul(x, one)
+		r := new(Complex).Mul(one, x)
+		return new(Complex).Add(x, zero).Equals(x) && l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Conversions
+
+func TestComplexComplex128(t *testing.T) {
+	big2 := func(e uint) *big.Int {
+		return new(big.Int).Lsh(big.NewInt(1), e)
+	}
+	odd := new(big.Int).Add(big2(53), big.NewInt(1))
+	var tests = []struct {
+		x    *Complex
+		want complex128
+	}{
+		{NewComplex(big.NewInt(0), big.NewInt(0)), 0},
+		{NewComplex(big.NewInt(3), big.NewInt(-4)), complex(3, -4)},
+		{NewComplex(odd, big.NewInt(1)), complex(1<<53, 1)},
+		{NewComplex(big2(1024), new(big.Int).Neg(big2(1024))),
+			complex(math.Inf(+1), math.Inf(-1))},
+	}
+	for _, test := range tests {
+		if got := test.x.Complex128(); got != test.want {
+			t.Errorf("Complex128(%v) = %v, want %v", test.x, got, test.want)
+		}
+	}
+}
+
+func TestComplexComplex128Exact(t *testing.T) {
+	f := func(a, b int32) bool {
+		// t.Logf("a = %d, b = %d", a, b)
+		x := NewComplex(big.NewInt(int64(a)), big.NewInt(int64(b)))
+		return x.Complex128() == complex(float64(a), float64(b))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// formatTerms formats the components v with the units symb, in the same
+// parenthesized layout as String, but with each component formatted as a
+// big.Int with the verb, flags, width, and precision of s. Every component
+// after the first always carries a sign.
+func formatTerms(s fmt.State, verb rune, v []*big.Int, symb []string) string {
+	a := make([]string, 0, 2*len(v)+1)
+	a = append(a, "(", fmt.Sprintf(formatString(s, verb, false), v[0]))
+	plus := formatString(s, verb, true)
+	for i := 1; i < len(v); i++ {
+		a = append(a, fmt.Sprintf(plus, v[i]), symb[i])
+	}
+	a = append(a, ")")
+	return strings.Join(a, "")
+}
+
+// formatString returns the format directive with the verb, flags, width, and
+// precision of s. If plus is true, then the '+' flag is always included.
+func formatString(s fmt.State, verb rune, plus bool) string {
+	b := []byte{'%'}
+	for _, f := range "+-# 0" {
+		if s.Flag(int(f)) || (plus && f == '+') {
+			b = append(b, byte(f))
+		}
+	}
+	if w, ok := s.Width(); ok {
+		b = strconv.AppendInt(b, int64(w), 10)
+	}
+	if p, ok := s.Precision(); ok {
+		b = append(b, '.')
+		b = strconv.AppendInt(b, int64(p), 10)
+	}
+	return string(b) + string(verb)
+}
+
+// compactTerms joins the nonzero components v with the units symb, omitting
+// zero terms, a leading '+', and unit coefficients of non-real terms. If every
+// component is zero, then the result is "0".
+func compactTerms(v []*big.Int, symb []string) string {
+	a := make([]string, 0, 2*len(v))
+	one := big.NewInt(1)
+	for i := range v {
+		if v[i].Sign() == 0 {
+			continue
+		}
+		if v[i].Sign() < 0 {
+			a = append(a, "-")
+		} else if len(a) > 0 {
+			a = append(a, "+")
+		}
+		abs := new(big.Int).Abs(v[i])
+		if symb[i] == "" || abs.Cmp(one) != 0 {
+			a = append(a, abs.String())
+		}
+		a = append(a, symb[i])
+	}
+	if len(a) == 0 {
+		return "0"
+	}
+	return strings.Join(a, "")
+}
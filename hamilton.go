@@ -4,6 +4,7 @@
 package integral
 
 import (
+	"flag"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -23,22 +24,44 @@ func (z *Hamilton) Real() *big.Int {
 	return (&z.l).Real()
 }
 
+// Unreal returns the vector part of z, that is, a copy of z with the real part
+// set to zero.
+func (z *Hamilton) Unreal() *Hamilton {
+	v := new(Hamilton).Set(z)
+	v.l.l.SetInt64(0)
+	return v
+}
+
 // Cartesian returns the four integral Cartesian components of z.
 func (z *Hamilton) Cartesian() (*big.Int, *big.Int, *big.Int, *big.Int) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// Components returns the four Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *Hamilton) Components() []*big.Int {
+	a, b, c, d := z.Cartesian()
+	return []*big.Int{a, b, c, d}
+}
+
 // String returns the string representation of a Hamilton value.
 //
 // If z corresponds to a + bi + cj + dk, then the string is"(a+bi+cj+dk)",
 // similar to complex128 values.
 func (z *Hamilton) String() string {
+	return z.StringWith(symbHamilton)
+}
+
+// StringWith returns the string representation of z with the units written as
+// symb, where symb[0] is the unit of the real part. The layout is the same as
+// that of String.
+func (z *Hamilton) StringWith(symb [4]string) string {
 	v := make([]*big.Int, 4)
 	v[0], v[1] = z.l.Cartesian()
 	v[2], v[3] = z.r.Cartesian()
 	a := make([]string, 9)
 	a[0] = "("
-	a[1] = fmt.Sprintf("%v", v[0])
+	a[1] = fmt.Sprintf("%v%s", v[0], symb[0])
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
 		if v[i].Sign() < 0 {
@@ -46,13 +69,82 @@ func (z *Hamilton) String() string {
 		} else {
 			a[j] = fmt.Sprintf("+%v", v[i])
 		}
-		a[j+1] = symbHamilton[i]
+		a[j+1] = symb[i]
 		i++
 	}
 	a[8] = ")"
 	return strings.Join(a, "")
 }
 
+// Compact returns a compact string representation of z. It is like String, but
+// zero terms are omitted and unit coefficients are collapsed, so that the
+// string is "(1)" or "(1-k)" instead of listing every component. The zero
+// value gives "(0)".
+func (z *Hamilton) Compact() string {
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return "(" + compactTerms(v, symbHamilton[:]) + ")"
+}
+
+// Format implements the fmt.Formatter interface. The verbs 'b', 'o', 'O', 'd',
+// 'x', and 'X' format each component of z like a big.Int, with the flags,
+// width, and precision forwarded. The '+' flag forces a sign on the real part;
+// the other parts always have a sign. Any other verb gives the same result as
+// String.
+func (z *Hamilton) Format(s fmt.State, ch rune) {
+	switch ch {
+	case 'b', 'o', 'O', 'd', 'x', 'X':
+		v := make([]*big.Int, 4)
+		v[0], v[1], v[2], v[3] = z.Cartesian()
+		fmt.Fprint(s, formatTerms(s, ch, v, symbHamilton[:]))
+	default:
+		fmt.Fprint(s, z.String())
+	}
+}
+
+// SetString sets z equal to the value of s, and returns z and a boolean
+// indicating success. The string s must be of the form "(a+bi+cj+dk)", where
+// omitted terms are zero. If SetString fails, the value of z is undefined but
+// the returned value is nil.
+func (z *Hamilton) SetString(s string) (*Hamilton, bool) {
+	v, ok := parseTerms(s, symbHamilton[:])
+	if !ok {
+		return nil, false
+	}
+	z.l.l.Set(v[0])
+	z.l.r.Set(v[1])
+	z.r.l.Set(v[2])
+	z.r.r.Set(v[3])
+	return z, true
+}
+
+// Flag returns a flag.Value that parses its argument into z.
+func (z *Hamilton) Flag() flag.Value {
+	return hamiltonValue{z}
+}
+
+// hamiltonValue adapts a *Hamilton to the flag.Value interface, since the Set
+// method of Hamilton is already taken.
+type hamiltonValue struct {
+	z *Hamilton
+}
+
+func (v hamiltonValue) String() string {
+	if v.z == nil {
+		return ""
+	}
+	return v.z.String()
+}
+
+func (v hamiltonValue) Set(s string) error {
+	y, ok := new(Hamilton).SetString(s)
+	if !ok {
+		return fmt.Errorf("invalid Hamilton value %q", s)
+	}
+	v.z.Set(y)
+	return nil
+}
+
 // Equals returns true if y and z are equal.
 func (z *Hamilton) Equals(y *Hamilton) bool {
 	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
@@ -61,6 +153,17 @@ func (z *Hamilton) Equals(y *Hamilton) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Hamilton) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Complex))
+}
+
+// IsUnreal returns true if the real part of z is zero and at least one of the
+// other components is not, so that z is a nonzero pure unreal value.
+func (z *Hamilton) IsUnreal() bool {
+	return z.l.l.Sign() == 0 && !z.IsReal()
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Hamilton) Set(y *Hamilton) *Hamilton {
 	z.l.Set(&y.l)
@@ -68,6 +171,31 @@ func (z *Hamilton) Set(y *Hamilton) *Hamilton {
 	return z
 }
 
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *Hamilton) Clone() *Hamilton {
+	return new(Hamilton).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *Hamilton) IsZero() bool {
+	return z.l.IsZero() && z.r.IsZero()
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *Hamilton) SetZero() *Hamilton {
+	z.l.SetZero()
+	z.r.SetZero()
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *Hamilton) SetOne() *Hamilton {
+	z.l.SetOne()
+	z.r.SetZero()
+	return z
+}
+
 // NewHamilton returns a pointer to the Hamilton value a+bi+cj+dk.
 func NewHamilton(a, b, c, d *big.Int) *Hamilton {
 	z := new(Hamilton)
@@ -78,6 +206,23 @@ func NewHamilton(a, b, c, d *big.Int) *Hamilton {
 	return z
 }
 
+// FromComplex sets z equal to the Gaussian integer x, viewed as a quaternion
+// with zero j and k components, and returns z. The image is the commutative
+// subalgebra spanned by 1 and i, on which Mul, Conj, and Quad agree with those
+// of Complex.
+func (z *Hamilton) FromComplex(x *Complex) *Hamilton {
+	z.l.Set(x)
+	z.r.SetZero()
+	return z
+}
+
+// ToComplex returns a pointer to a new Complex value holding the real and i
+// components of z, and true if the j and k components of z are zero. Otherwise
+// the projection is still returned, but with false.
+func (z *Hamilton) ToComplex() (*Complex, bool) {
+	return new(Complex).Set(&z.l), z.r.IsZero()
+}
+
 // Scal sets z equal to y scaled by a, and returns z.
 func (z *Hamilton) Scal(y *Hamilton, a *big.Int) *Hamilton {
 	z.l.Scal(&y.l, a)
@@ -113,6 +258,16 @@ func (z *Hamilton) Sub(x, y *Hamilton) *Hamilton {
 	return z
 }
 
+// Sum sets z equal to the sum of xs, and returns z. If xs is empty, then z is
+// zero.
+func (z *Hamilton) Sum(xs ...*Hamilton) *Hamilton {
+	s := new(Hamilton)
+	for _, x := range xs {
+		s.Add(s, x)
+	}
+	return z.Set(s)
+}
+
 // Mul sets z equal to the product of x and y, and returns z.
 //
 // The multiplication rules are:
@@ -146,6 +301,20 @@ func (z *Hamilton) Commutator(x, y *Hamilton) *Hamilton {
 	)
 }
 
+// Prod sets z equal to the product of xs, taken left to right, and returns z.
+// That is, Prod(x0, x1, ..., xn) is
+// 		Mul(Mul(Mul(x0, x1), ...), xn)
+// The order of the factors matters since multiplication is noncommutative, but
+// the grouping does not since it is associative. If xs is empty, then z is one.
+func (z *Hamilton) Prod(xs ...*Hamilton) *Hamilton {
+	p := new(Hamilton)
+	p.l.l.SetInt64(1)
+	for _, x := range xs {
+		p.Mul(p, x)
+	}
+	return z.Set(p)
+}
+
 // Quad returns the quadrance of z. If z = a+bi+cj+dk, then the quadrance is
 // 		Mul(a, a) + Mul(b, b) + Mul(c, c) + Mul(d, d)
 // This is always non-negative.
@@ -156,6 +325,115 @@ func (z *Hamilton) Quad() *big.Int {
 	)
 }
 
+// Abs returns the integral part of the Euclidean norm of z, that is, the floor
+// of the square root of Quad(z). Use NormIsExact to tell if it is the exact
+// norm.
+func (z *Hamilton) Abs() *big.Int {
+	abs := z.Quad()
+	return abs.Sqrt(abs)
+}
+
+// NormIsExact returns true if Quad(z) is a perfect square, so that Abs returns
+// the exact Euclidean norm of z.
+func (z *Hamilton) NormIsExact() bool {
+	quad := z.Quad()
+	abs := new(big.Int).Sqrt(quad)
+	return abs.Mul(abs, abs).Cmp(quad) == 0
+}
+
+// Float64s returns the four Cartesian components of z, each rounded to the
+// nearest float64. Components beyond 2⁵³ in magnitude may lose precision, and
+// components too large for a float64 become ±Inf.
+func (z *Hamilton) Float64s() [4]float64 {
+	return [4]float64{
+		float64Of(&z.l.l),
+		float64Of(&z.l.r),
+		float64Of(&z.r.l),
+		float64Of(&z.r.r),
+	}
+}
+
+// Dot returns the Euclidean inner product of z and y. If z = a+bi+cj+dk and
+// y = e+fi+gj+hk, then the inner product is the real part of Mul(z, Conj(y)):
+// 		Mul(a, e) + Mul(b, f) + Mul(c, g) + Mul(d, h)
+// Note that Dot(z, z) is the quadrance of z.
+func (z *Hamilton) Dot(y *Hamilton) *big.Int {
+	dot := new(big.Int)
+	temp := new(big.Int)
+	dot.Mul(&z.l.l, &y.l.l)
+	dot.Add(dot, temp.Mul(&z.l.r, &y.l.r))
+	dot.Add(dot, temp.Mul(&z.r.l, &y.r.l))
+	return dot.Add(dot, temp.Mul(&z.r.r, &y.r.r))
+}
+
+// Cross sets z equal to the cross product of the vector parts of x and y, and
+// returns z. The real parts of x and y are ignored, and the real part of z is
+// zero. If x = a+bi+cj+dk and y = e+fi+gj+hk, then the cross product is
+// 		(Mul(c, h) - Mul(d, g))i + (Mul(d, f) - Mul(b, h))j +
+// 		(Mul(b, g) - Mul(c, f))k
+// This is the vector part of Mul(x, y) when x and y are pure vectors.
+func (z *Hamilton) Cross(x, y *Hamilton) *Hamilton {
+	_, b, c, d := x.Cartesian()
+	_, f, g, h := y.Cartesian()
+	temp := new(big.Int)
+	i := new(big.Int).Mul(c, h)
+	i.Sub(i, temp.Mul(d, g))
+	j := new(big.Int).Mul(d, f)
+	j.Sub(j, temp.Mul(b, h))
+	k := new(big.Int).Mul(b, g)
+	k.Sub(k, temp.Mul(c, f))
+	z.l.l.SetInt64(0)
+	z.l.r.Set(i)
+	z.r.l.Set(j)
+	z.r.r.Set(k)
+	return z
+}
+
+// CosNum returns the numerator and the denominator of the squared cosine of the
+// angle between z and y:
+// 		Mul(Dot(z, y), Dot(z, y))
+// 		Mul(Quad(z), Quad(y))
+// Angles can then be compared exactly by cross-multiplication. If z or y is
+// zero, then both values are zero.
+func (z *Hamilton) CosNum(y *Hamilton) (*big.Int, *big.Int) {
+	num := z.Dot(y)
+	num.Mul(num, num)
+	den := z.Quad()
+	den.Mul(den, y.Quad())
+	return num, den
+}
+
+// GramHamilton returns the Gram matrix of xs, that is, the symmetric n×n
+// matrix of the pairwise inner products Dot(xs[i], xs[j]).
+func GramHamilton(xs []*Hamilton) [][]*big.Int {
+	g := make([][]*big.Int, len(xs))
+	for i := range g {
+		g[i] = make([]*big.Int, len(xs))
+	}
+	for i := range xs {
+		for j := i; j < len(xs); j++ {
+			g[i][j] = xs[i].Dot(xs[j])
+			g[j][i] = new(big.Int).Set(g[i][j])
+		}
+	}
+	return g
+}
+
+// Trace returns the reduced trace of z. If z = a+bi+cj+dk, then the trace is
+// 		Add(z, Conj(z)) = Mul(2, a)
+func (z *Hamilton) Trace() *big.Int {
+	return new(big.Int).Lsh(&z.l.l, 1)
+}
+
+// TraceForm returns the trace bilinear form of x and y:
+// 		Trace(Mul(x, Conj(y)))
+// This is symmetric, and TraceForm(x, x) is twice the quadrance of x. The
+// receiver z is not modified.
+func (z *Hamilton) TraceForm(x, y *Hamilton) *big.Int {
+	p := new(Hamilton).Conj(y)
+	return p.Mul(x, p).Trace()
+}
+
 // Quo sets z equal to the quotient of x and y, and returns z. Note that
 // truncated division is used.
 func (z *Hamilton) Quo(x, y *Hamilton) *Hamilton {
@@ -172,6 +450,471 @@ func (z *Hamilton) Quo(x, y *Hamilton) *Hamilton {
 	return z
 }
 
+// ExactQuo sets z equal to the quotient of x and y, as given by Quo, and
+// returns z and true, if Quad(y) divides every component of
+// 		Mul(x, Conj(y))
+// so that Mul(z, y) = x. Otherwise z is not modified, and ExactQuo returns nil
+// and false. If y is zero, then ExactQuo panics.
+func (z *Hamilton) ExactQuo(x, y *Hamilton) (*Hamilton, bool) {
+	if zero := new(Hamilton); y.Equals(zero) {
+		panic("zero denominator")
+	}
+	p := new(Hamilton).Conj(y)
+	p.Mul(x, p)
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = p.Cartesian()
+	if !quoExact(v, y.Quad()) {
+		return nil, false
+	}
+	return z.Set(p), true
+}
+
+// QuoRem sets z equal to the right quotient of x and y, with each component of
+// 		Mul(x, Conj(y)) / Quad(y)
+// rounded to the nearest integer, and sets r equal to the remainder
+// 		Sub(x, Mul(z, y))
+// Then it returns z and r, so that x = Mul(z, y) + r.
+//
+// Since each of the four components is off by at most one half, Quad(r) is at
+// most Quad(y). The inequality is strict unless every component of the
+// unrounded quotient has fractional part one half, as for (1+i+j+k) and 2;
+// the Lipschitz order is not Euclidean, and a strict decrease in every case
+// needs the Hurwitz order. If y is zero, then QuoRem panics.
+func (z *Hamilton) QuoRem(x, y, r *Hamilton) (*Hamilton, *Hamilton) {
+	if zero := new(Hamilton); y.Equals(zero) {
+		panic("zero denominator")
+	}
+	a := new(Hamilton).Set(x)
+	b := new(Hamilton).Set(y)
+	z.quoRound(z.Mul(a, z.Conj(b)), b.Quad())
+	r.Sub(a, r.Mul(z, b))
+	return z, r
+}
+
+// quoRemLeft sets z equal to the left quotient of x and y, with each component
+// of
+// 		Mul(Conj(y), x) / Quad(y)
+// rounded to the nearest integer, and sets r equal to the remainder
+// 		Sub(x, Mul(y, z))
+// Then it returns z and r. The bound on Quad(r) is the same as for QuoRem.
+func (z *Hamilton) quoRemLeft(x, y, r *Hamilton) (*Hamilton, *Hamilton) {
+	if zero := new(Hamilton); y.Equals(zero) {
+		panic("zero denominator")
+	}
+	a := new(Hamilton).Set(x)
+	b := new(Hamilton).Set(y)
+	z.quoRound(z.Mul(z.Conj(b), a), b.Quad())
+	r.Sub(a, r.Mul(b, z))
+	return z, r
+}
+
+// quoRound sets z equal to x divided by the positive integer d, with each
+// component rounded to the nearest integer, and returns z. Halves are rounded
+// up.
+func (z *Hamilton) quoRound(x *Hamilton, d *big.Int) *Hamilton {
+	z.Set(x)
+	twice := new(big.Int).Lsh(d, 1)
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	for _, e := range v {
+		e.Div(e.Add(e.Lsh(e, 1), d), twice)
+	}
+	return z
+}
+
+// GCDRight sets z equal to a greatest common right divisor g of x and y, so
+// that x = Mul(a, g) and y = Mul(b, g), and returns z. The Euclidean algorithm
+// is used with the right division of QuoRem. The result is unique up to a unit
+// on the left, and it is normalized to the largest of its left associates in
+// the lexicographic order of the components. If y is zero, then z is the
+// normalized x. If both x and y are zero, then z is zero.
+//
+// The Lipschitz order is not Euclidean, and a greatest common right divisor
+// need not exist. If a remainder fails to decrease in quadrance, then the value
+// of z is undefined and GCDRight returns nil.
+func (z *Hamilton) GCDRight(x, y *Hamilton) *Hamilton {
+	a := new(Hamilton).Set(x)
+	b := new(Hamilton).Set(y)
+	zero := new(Hamilton)
+	q, temp := new(Hamilton), new(Hamilton)
+	for !b.Equals(zero) {
+		if q.QuoRem(a, b, temp); temp.Quad().Cmp(b.Quad()) >= 0 {
+			return nil
+		}
+		a, b, temp = b, temp, a
+	}
+	return z.normalize(a, true)
+}
+
+// GCDLeft sets z equal to a greatest common left divisor g of x and y, so that
+// x = Mul(g, a) and y = Mul(g, b), and returns z. It is like GCDRight, but it
+// uses left division, and the result is normalized among its right associates.
+// If no greatest common left divisor is found, then the value of z is
+// undefined and GCDLeft returns nil.
+func (z *Hamilton) GCDLeft(x, y *Hamilton) *Hamilton {
+	a := new(Hamilton).Set(x)
+	b := new(Hamilton).Set(y)
+	zero := new(Hamilton)
+	q, temp := new(Hamilton), new(Hamilton)
+	for !b.Equals(zero) {
+		if q.quoRemLeft(a, b, temp); temp.Quad().Cmp(b.Quad()) >= 0 {
+			return nil
+		}
+		a, b, temp = b, temp, a
+	}
+	return z.normalize(a, false)
+}
+
+// normalize sets z equal to the largest associate of y in the lexicographic
+// order of the components, and returns z. If left is true, then the associates
+// are Mul(u, y), and otherwise they are Mul(y, u), where u is one of the eight
+// units ±1, ±i, ±j, and ±k.
+func (z *Hamilton) normalize(y *Hamilton, left bool) *Hamilton {
+	best := new(Hamilton).Set(y)
+	u, w := new(Hamilton), new(Hamilton)
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = u.Cartesian()
+	for _, e := range v {
+		for _, sign := range []int64{1, -1} {
+			u.Set(new(Hamilton))
+			e.SetInt64(sign)
+			if left {
+				w.Mul(u, y)
+			} else {
+				w.Mul(y, u)
+			}
+			if w.cmpLex(best) > 0 {
+				best.Set(w)
+			}
+		}
+	}
+	return z.Set(best)
+}
+
+// cmpLex compares z and y in the lexicographic order of their components, and
+// returns -1, 0, or +1.
+func (z *Hamilton) cmpLex(y *Hamilton) int {
+	a := make([]*big.Int, 4)
+	a[0], a[1], a[2], a[3] = z.Cartesian()
+	b := make([]*big.Int, 4)
+	b[0], b[1], b[2], b[3] = y.Cartesian()
+	for i := range a {
+		if c := a[i].Cmp(b[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// Inv sets z equal to the inverse of y, and returns nil. The inverse of y is
+// 		Conj(y) / Quad(y)
+// which is integral only if Quad(y) divides every component, that is, only if
+// y is a unit (±1, ±i, ±j, or ±k). If y is zero, then Inv returns
+// ErrZeroDivisor; if the inverse is not integral, then Inv returns
+// ErrNotIntegral. In both cases z is not modified.
+func (z *Hamilton) Inv(y *Hamilton) error {
+	if zero := new(Hamilton); y.Equals(zero) {
+		return ErrZeroDivisor
+	}
+	c := new(Hamilton).Conj(y)
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = c.Cartesian()
+	if !quoExact(v, y.Quad()) {
+		return ErrNotIntegral
+	}
+	z.Set(c)
+	return nil
+}
+
+// Pow sets z equal to x raised to the nth power, and returns z. Binary
+// exponentiation is used, which is well-defined since multiplication is
+// associative. If n is zero, then z is one. If n is negative, then z is the
+// quotient of one and x raised to the -n power, as given by Quo, so Pow panics
+// if x is zero.
+func (z *Hamilton) Pow(x *Hamilton, n int) *Hamilton {
+	if n < 0 {
+		p := new(Hamilton).Pow(x, -n)
+		zero := new(big.Int)
+		return z.Quo(NewHamilton(big.NewInt(1), zero, zero, zero), p)
+	}
+	p := new(Hamilton).Set(x)
+	z.Set(new(Hamilton))
+	z.l.l.SetInt64(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			z.Mul(z, p)
+		}
+		if n > 1 {
+			p.Mul(p, p)
+		}
+	}
+	return z
+}
+
+// ReducePowerSeries returns the value of the polynomial with coefficients coeffs
+// at z, that is, the sum of Scal(Pow(z, k), coeffs[k]). No powers of z are
+// computed; instead, the minimal polynomial
+// 		Mul(z, z) = Trace(z) z - Quad(z)
+// is used to keep every power in the form α + βz, with α and β integers.
+func (z *Hamilton) ReducePowerSeries(coeffs []*big.Int) *Hamilton {
+	trace, quad := z.Trace(), z.Quad()
+	alpha, beta := big.NewInt(1), new(big.Int)
+	a, b := new(big.Int), new(big.Int)
+	temp := new(big.Int)
+	for k, c := range coeffs {
+		if k > 0 {
+			// Mul(α + βz, z) = -βQuad(z) + (α + βTrace(z))z
+			next := new(big.Int).Mul(beta, quad)
+			beta.Add(alpha, temp.Mul(beta, trace))
+			alpha = next.Neg(next)
+		}
+		a.Add(a, temp.Mul(c, alpha))
+		b.Add(b, temp.Mul(c, beta))
+	}
+	p := new(Hamilton).Scal(z, b)
+	p.l.l.Add(&p.l.l, a)
+	return p
+}
+
+// AxisPrimitive returns the primitive lattice vector along the vector part of
+// z, that is, the vector part divided by the greatest common divisor of its
+// components, and true. This is the axis of the rotation given by z. If z is
+// real, then no axis is defined, and AxisPrimitive returns nil and false.
+func (z *Hamilton) AxisPrimitive() (*Hamilton, bool) {
+	_, b, c, d := z.Cartesian()
+	g := new(big.Int)
+	for _, e := range []*big.Int{b, c, d} {
+		g.GCD(nil, nil, g, new(big.Int).Abs(e))
+	}
+	if g.Sign() == 0 {
+		return nil, false
+	}
+	u := NewHamilton(new(big.Int), b, c, d)
+	u.l.r.Quo(&u.l.r, g)
+	u.r.l.Quo(&u.r.l, g)
+	u.r.r.Quo(&u.r.r, g)
+	return u, true
+}
+
+// Rotate returns the sandwich product
+// 		Mul(z, v, Conj(z))
+// where v is treated as a pure vector, so its real part is ignored. The result
+// is the rotation of v by z scaled by Quad(z), and it is a pure vector. The
+// receiver z is not modified.
+func (z *Hamilton) Rotate(v *Hamilton) *Hamilton {
+	return z.rotate(v, new(Hamilton).Conj(z))
+}
+
+// RotateExact returns the rotation of v by z, that is, Rotate(v) divided by
+// Quad(z), and true, if the division is exact. This is always the case when z
+// is a unit. Otherwise it returns nil and false. If z is zero, then RotateExact
+// panics. The receiver z is not modified.
+func (z *Hamilton) RotateExact(v *Hamilton) (*Hamilton, bool) {
+	quad := z.Quad()
+	if quad.Sign() == 0 {
+		panic("zero rotation")
+	}
+	p := z.Rotate(v)
+	w := make([]*big.Int, 4)
+	w[0], w[1], w[2], w[3] = p.Cartesian()
+	if !quoExact(w, quad) {
+		return nil, false
+	}
+	return p, true
+}
+
+// RotationMatrix returns the 3×3 integral matrix of the rotation given by z,
+// scaled by Quad(z), so that its product with the column vector of the
+// components of v is the vector part of Rotate(v). If z = a+bi+cj+dk, then the
+// matrix is
+// 		aa+bb-cc-dd   2(bc-ad)      2(bd+ac)
+// 		2(bc+ad)      aa-bb+cc-dd   2(cd-ab)
+// 		2(bd-ac)      2(cd+ab)      aa-bb-cc+dd
+// If z is a unit, then this is exactly a matrix in SO(3).
+func (z *Hamilton) RotationMatrix() [3][3]*big.Int {
+	a, b, c, d := z.Cartesian()
+	bb := new(big.Int).Mul(b, b)
+	cc := new(big.Int).Mul(c, c)
+	dd := new(big.Int).Mul(d, d)
+	// base is aa-bb-cc-dd, and each diagonal entry adds twice a square to it.
+	base := new(big.Int).Mul(a, a)
+	base.Sub(base, bb)
+	base.Sub(base, cc)
+	base.Sub(base, dd)
+	diag := func(xx *big.Int) *big.Int {
+		e := new(big.Int).Lsh(xx, 1)
+		return e.Add(e, base)
+	}
+	// off returns 2(xy+uv) if plus is true, and 2(xy-uv) otherwise.
+	off := func(x, y, u, v *big.Int, plus bool) *big.Int {
+		e := new(big.Int).Mul(x, y)
+		if t := new(big.Int).Mul(u, v); plus {
+			e.Add(e, t)
+		} else {
+			e.Sub(e, t)
+		}
+		return e.Lsh(e, 1)
+	}
+	return [3][3]*big.Int{
+		{diag(bb), off(b, c, a, d, false), off(b, d, a, c, true)},
+		{off(b, c, a, d, true), diag(cc), off(c, d, a, b, false)},
+		{off(b, d, a, c, false), off(c, d, a, b, true), diag(dd)},
+	}
+}
+
+// Matrix returns the 4×4 integral matrix of left multiplication by z, so that
+// its product with the column vector of the components of v gives the
+// components of Mul(z, v). If z = a+bi+cj+dk, then the matrix is
+// 		a  -b  -c  -d
+// 		b   a  -d   c
+// 		c   d   a  -b
+// 		d  -c   b   a
+// Its determinant is the square of Quad(z).
+func (z *Hamilton) Matrix() [4][4]*big.Int {
+	a, b, c, d := z.Cartesian()
+	neg := func(x *big.Int) *big.Int {
+		return new(big.Int).Neg(x)
+	}
+	cp := func(x *big.Int) *big.Int {
+		return new(big.Int).Set(x)
+	}
+	return [4][4]*big.Int{
+		{cp(a), neg(b), neg(c), neg(d)},
+		{cp(b), cp(a), neg(d), cp(c)},
+		{cp(c), cp(d), cp(a), neg(b)},
+		{cp(d), neg(c), cp(b), cp(a)},
+	}
+}
+
+// SetMatrix sets z equal to the value whose matrix, as given by Matrix, is m,
+// and returns z and true. If m is not of that form, then z is not modified,
+// and SetMatrix returns nil and false.
+func (z *Hamilton) SetMatrix(m [4][4]*big.Int) (*Hamilton, bool) {
+	y := NewHamilton(m[0][0], m[1][0], m[2][0], m[3][0])
+	n := y.Matrix()
+	for i := range m {
+		for j := range m[i] {
+			if m[i][j].Cmp(n[i][j]) != 0 {
+				return nil, false
+			}
+		}
+	}
+	return z.Set(y), true
+}
+
+// rotate returns Mul(z, v, c), where c is the conjugate of z and the real part
+// of v is ignored.
+func (z *Hamilton) rotate(v, c *Hamilton) *Hamilton {
+	p := new(Hamilton).Set(v)
+	p.l.l.SetInt64(0)
+	p.Mul(z, p)
+	return p.Mul(p, c)
+}
+
+// RotateAll returns the rotations of points by z, as given by Rotate, together
+// with the common scale factor Quad(z). The conjugate of z is computed once and
+// shared by every point. The receiver z is not modified.
+func (z *Hamilton) RotateAll(points []*Hamilton) ([]*Hamilton, *big.Int) {
+	c := new(Hamilton).Conj(z)
+	rotated := make([]*Hamilton, len(points))
+	for i, v := range points {
+		rotated[i] = z.rotate(v, c)
+	}
+	return rotated, z.Quad()
+}
+
+// ConjugacyMeasure returns a nonzero u such that
+// 		Mul(u, x) = Mul(y, u)
+// and true, if x and y are conjugate, that is, if they have the same real part
+// and the same quadrance. Otherwise it returns nil and false. The receiver z is
+// not modified.
+//
+// If x = r+a and y = r+b, where a and b are the vector parts, then u = a+b,
+// unless b = -a, in which case u is a vector orthogonal to a. If x and y are
+// equal, then u is one.
+func (z *Hamilton) ConjugacyMeasure(x, y *Hamilton) (*Hamilton, bool) {
+	if x.Real().Cmp(y.Real()) != 0 || x.Quad().Cmp(y.Quad()) != 0 {
+		return nil, false
+	}
+	zero := new(Hamilton)
+	one := NewHamilton(big.NewInt(1), new(big.Int), new(big.Int), new(big.Int))
+	if x.Equals(y) {
+		return one, true
+	}
+	a := new(Hamilton).Set(x)
+	a.l.l.SetInt64(0)
+	b := new(Hamilton).Set(y)
+	b.l.l.SetInt64(0)
+	if u := new(Hamilton).Add(a, b); !u.Equals(zero) {
+		return u, true
+	}
+	e := new(Hamilton)
+	u := new(Hamilton)
+	for _, c := range []*big.Int{&e.l.r, &e.r.l, &e.r.r} {
+		c.SetInt64(1)
+		if u.Commutator(a, e); !u.Equals(zero) {
+			return u, true
+		}
+		c.SetInt64(0)
+	}
+	return nil, false
+}
+
+// NearestUnit returns the Lipschitz unit (±1, ±i, ±j, or ±k) that is closest
+// to z, in the sense that the quadrance of their difference is minimal. This is
+// the unit along the component of z with the largest absolute value, with the
+// sign of that component. Ties are broken in favor of the earlier component in
+// the order 1, i, j, k, and in favor of the positive unit.
+func (z *Hamilton) NearestUnit() *Hamilton {
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	n := 0
+	for i := 1; i < 4; i++ {
+		if v[i].CmpAbs(v[n]) > 0 {
+			n = i
+		}
+	}
+	u := make([]*big.Int, 4)
+	for i := range u {
+		u[i] = new(big.Int)
+	}
+	if v[n].Sign() < 0 {
+		u[n].SetInt64(-1)
+	} else {
+		u[n].SetInt64(1)
+	}
+	return NewHamilton(u[0], u[1], u[2], u[3])
+}
+
+// HamiltonBall returns all the Hamilton values with quadrance at most maxQuad.
+// If maxQuad is negative, then the result is empty.
+//
+// The number of values grows like the square of maxQuad, so HamiltonBall is
+// only meant for brute-force searches with small bounds.
+func HamiltonBall(maxQuad *big.Int) []*Hamilton {
+	var zs []*Hamilton
+	if maxQuad.Sign() < 0 {
+		return zs
+	}
+	one := big.NewInt(1)
+	v := make([]*big.Int, 4)
+	var walk func(i int, rem *big.Int)
+	walk = func(i int, rem *big.Int) {
+		if i == len(v) {
+			zs = append(zs, NewHamilton(v[0], v[1], v[2], v[3]))
+			return
+		}
+		r := new(big.Int).Sqrt(rem)
+		for c := new(big.Int).Neg(r); c.Cmp(r) <= 0; c.Add(c, one) {
+			v[i] = c
+			sq := new(big.Int).Mul(c, c)
+			walk(i+1, sq.Sub(rem, sq))
+		}
+	}
+	walk(0, maxQuad)
+	return zs
+}
+
 // Generate returns a random Hamilton value for quick.Check testing.
 func (z *Hamilton) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomHamilton := &Hamilton{
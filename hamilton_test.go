@@ -4,6 +4,10 @@
 package integral
 
 import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
 	"math/big"
 	"testing"
 	"testing/quick"
@@ -302,3 +306,1101 @@ func TestHamiltonComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Parsing
+
+func TestHamiltonStringSetString(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		l, ok := new(Hamilton).SetString(x.String())
+		return ok && l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonFlag(t *testing.T) {
+	z := new(Hamilton)
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(z.Flag(), "q", "a Hamilton quaternion")
+	if err := fs.Parse([]string{"-q", "(1+2i+3j+4k)"}); err != nil {
+		t.Fatal(err)
+	}
+	want := NewHamilton(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4))
+	if !z.Equals(want) {
+		t.Errorf("got %v, want %v", z, want)
+	}
+	fs.SetOutput(io.Discard)
+	if err := fs.Parse([]string{"-q", "(1+2x)"}); err == nil {
+		t.Error("parsing (1+2x) succeeded, want error")
+	}
+}
+
+// Units
+
+func TestHamiltonNearestUnit(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z, want *Hamilton
+	}{
+		{h(9, 2, -3, 1), h(1, 0, 0, 0)},
+		{h(-9, 2, -3, 1), h(-1, 0, 0, 0)},
+		{h(1, 7, -3, 2), h(0, 1, 0, 0)},
+		{h(1, -7, -3, 2), h(0, -1, 0, 0)},
+		{h(1, 2, 8, -3), h(0, 0, 1, 0)},
+		{h(1, 2, -8, -3), h(0, 0, -1, 0)},
+		{h(1, 2, 3, 5), h(0, 0, 0, 1)},
+		{h(1, 2, 3, -5), h(0, 0, 0, -1)},
+	}
+	for _, test := range tests {
+		if got := test.z.NearestUnit(); !got.Equals(test.want) {
+			t.Errorf("NearestUnit(%v) = %v, want %v", test.z, got, test.want)
+		}
+	}
+}
+
+func TestHamiltonNearestUnitTie(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z, want *Hamilton
+	}{
+		{h(0, 0, 0, 0), h(1, 0, 0, 0)},
+		{h(0, -3, 3, 0), h(0, -1, 0, 0)},
+		{h(2, 2, 2, 2), h(1, 0, 0, 0)},
+		{h(0, 1, -4, 4), h(0, 0, -1, 0)},
+	}
+	for _, test := range tests {
+		if got := test.z.NearestUnit(); !got.Equals(test.want) {
+			t.Errorf("NearestUnit(%v) = %v, want %v", test.z, got, test.want)
+		}
+	}
+}
+
+func TestHamiltonNearestUnitMinimal(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	units := []*Hamilton{
+		NewHamilton(one, zero, zero, zero),
+		NewHamilton(zero, one, zero, zero),
+		NewHamilton(zero, zero, one, zero),
+		NewHamilton(zero, zero, zero, one),
+	}
+	for i := 0; i < 4; i++ {
+		units = append(units, new(Hamilton).Neg(units[i]))
+	}
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		d := new(Hamilton).Sub(x, x.NearestUnit()).Quad()
+		for _, u := range units {
+			if new(Hamilton).Sub(x, u).Quad().Cmp(d) < 0 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Formatting
+
+func TestHamiltonFormat(t *testing.T) {
+	z := NewHamilton(big.NewInt(255), big.NewInt(-16), big.NewInt(0), big.NewInt(10))
+	var tests = []struct {
+		format, want string
+	}{
+		{"%v", "(255-16i+0j+10k)"},
+		{"%d", "(255-16i+0j+10k)"},
+		{"%+d", "(+255-16i+0j+10k)"},
+		{"%x", "(ff-10i+0j+ak)"},
+		{"%#X", "(0XFF-0X10i+0X0j+0XAk)"},
+		{"%4d", "( 255 -16i  +0j +10k)"},
+		{"%.3d", "(255-016i+000j+010k)"},
+	}
+	for _, test := range tests {
+		if got := fmt.Sprintf(test.format, z); got != test.want {
+			t.Errorf("Sprintf(%q, %v) = %q, want %q", test.format, z, got, test.want)
+		}
+	}
+}
+
+// Trace form
+
+func TestHamiltonTraceFormQuad(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		l := new(Hamilton).TraceForm(x, x)
+		r := new(big.Int).Lsh(x.Quad(), 1)
+		return l.Cmp(r) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonTraceFormSymmetric(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Hamilton).TraceForm(x, y)
+		r := new(Hamilton).TraceForm(y, x)
+		return l.Cmp(r) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonStringWith(t *testing.T) {
+	z := NewHamilton(big.NewInt(1), big.NewInt(-2), big.NewInt(0), big.NewInt(4))
+	symb := [4]string{"", "𝐢", "𝐣", "𝐤"}
+	want := "(1-2𝐢+0𝐣+4𝐤)"
+	if got := z.StringWith(symb); got != want {
+		t.Errorf("StringWith(%v) = %q, want %q", symb, got, want)
+	}
+}
+
+// Gram matrix
+
+func TestGramHamiltonOrthogonal(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	xs := []*Hamilton{h(1, 1, 0, 0), h(1, -1, 0, 0), h(0, 0, 2, 3), h(0, 0, -3, 2)}
+	g := GramHamilton(xs)
+	if len(g) != len(xs) {
+		t.Fatalf("len(GramHamilton) = %v, want %v", len(g), len(xs))
+	}
+	for i := range g {
+		if len(g[i]) != len(xs) {
+			t.Fatalf("len(GramHamilton[%v]) = %v, want %v", i, len(g[i]), len(xs))
+		}
+		for j := range g[i] {
+			want := new(big.Int)
+			if i == j {
+				want = xs[i].Quad()
+			}
+			if g[i][j].Cmp(want) != 0 {
+				t.Errorf("GramHamilton[%v][%v] = %v, want %v", i, j, g[i][j], want)
+			}
+		}
+	}
+}
+
+func TestHamiltonDotQuad(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		return x.Dot(x).Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonDotSymmetric(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p := new(Hamilton).Mul(x, new(Hamilton).Conj(y))
+		return x.Dot(y).Cmp(y.Dot(x)) == 0 && x.Dot(y).Cmp(p.Real()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonCompact(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	var tests = []struct {
+		z    *Hamilton
+		want string
+	}{
+		{new(Hamilton), "(0)"},
+		{NewHamilton(big.NewInt(-5), zero, zero, zero), "(-5)"},
+		{NewHamilton(zero, one, zero, big.NewInt(-1)), "(i-k)"},
+		{NewHamilton(big.NewInt(2), zero, big.NewInt(-4), zero), "(2-4j)"},
+	}
+	for _, test := range tests {
+		if got := test.z.Compact(); got != test.want {
+			t.Errorf("Compact(%v) = %q, want %q", test.z, got, test.want)
+		}
+	}
+}
+
+// Angles
+
+func TestHamiltonCosNumOrthogonal(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	x, y := h(1, 2, 3, 4), h(2, -1, 4, -3)
+	num, den := x.CosNum(y)
+	if num.Sign() != 0 || den.Cmp(big.NewInt(900)) != 0 {
+		t.Errorf("CosNum(%v, %v) = %v, %v, want 0, 900", x, y, num, den)
+	}
+}
+
+func TestHamiltonCosNumParallel(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		y := new(Hamilton).Scal(x, big.NewInt(-3))
+		num, den := x.CosNum(y)
+		return num.Cmp(den) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Enumeration
+
+func TestHamiltonBall(t *testing.T) {
+	var tests = []struct {
+		maxQuad int64
+		want    int
+	}{
+		{-1, 0},
+		{0, 1},
+		{1, 9},
+		{2, 33},
+		{3, 65},
+	}
+	for _, test := range tests {
+		max := big.NewInt(test.maxQuad)
+		zs := HamiltonBall(max)
+		if len(zs) != test.want {
+			t.Errorf("len(HamiltonBall(%v)) = %v, want %v", max, len(zs), test.want)
+		}
+		for _, z := range zs {
+			if z.Quad().Cmp(max) > 0 {
+				t.Errorf("HamiltonBall(%v) contains %v", max, z)
+			}
+		}
+	}
+}
+
+// Powers
+
+func TestHamiltonPowCube(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		l := new(Hamilton).Pow(x, 3)
+		r := new(Hamilton).Mul(new(Hamilton).Mul(x, x), x)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonPow(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		x    *Hamilton
+		n    int
+		want *Hamilton
+	}{
+		{h(1, 2, 3, 4), 0, h(1, 0, 0, 0)},
+		{h(0, 0, 1, 0), 2, h(-1, 0, 0, 0)},
+		{h(0, 0, 0, 1), -1, h(0, 0, 0, -1)},
+		{h(1, 1, 1, 1), 3, h(-8, 0, 0, 0)},
+		{h(1, 1, 1, 1), 6, h(64, 0, 0, 0)},
+	}
+	for _, test := range tests {
+		if got := new(Hamilton).Pow(test.x, test.n); !got.Equals(test.want) {
+			t.Errorf("Pow(%v, %v) = %v, want %v", test.x, test.n, got, test.want)
+		}
+	}
+}
+
+// Conjugacy
+
+func TestHamiltonConjugacyMeasure(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		x, y *Hamilton
+	}{
+		{h(0, 1, 0, 0), h(0, -1, 0, 0)},
+		{h(0, 1, 0, 0), h(0, 0, 1, 0)},
+		{h(0, 0, 0, 1), h(0, 0, 0, -1)},
+		{h(0, 0, 1, 0), h(0, 0, 1, 0)},
+		{h(1, 1, 1, 1), h(1, -1, -1, -1)},
+		{h(2, 3, 0, 4), h(2, 0, 5, 0)},
+	}
+	zero := new(Hamilton)
+	for _, test := range tests {
+		u, ok := new(Hamilton).ConjugacyMeasure(test.x, test.y)
+		if !ok || u.Equals(zero) {
+			t.Errorf("ConjugacyMeasure(%v, %v) = %v, %v", test.x, test.y, u, ok)
+			continue
+		}
+		l := new(Hamilton).Mul(u, test.x)
+		r := new(Hamilton).Mul(test.y, u)
+		if !l.Equals(r) {
+			t.Errorf("ConjugacyMeasure(%v, %v) = %v does not conjugate", test.x, test.y, u)
+		}
+	}
+}
+
+func TestHamiltonConjugacyMeasureNotConjugate(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		x, y *Hamilton
+	}{
+		{h(1, 0, 0, 0), h(-1, 0, 0, 0)},
+		{h(0, 1, 0, 0), h(1, 0, 0, 0)},
+		{h(0, 1, 1, 0), h(0, 0, 1, 0)},
+	}
+	for _, test := range tests {
+		if u, ok := new(Hamilton).ConjugacyMeasure(test.x, test.y); ok {
+			t.Errorf("ConjugacyMeasure(%v, %v) = %v, true, want false", test.x, test.y, u)
+		}
+	}
+}
+
+func TestHamiltonReducePowerSeries(t *testing.T) {
+	f := func(x *Hamilton, cs []int16) bool {
+		// t.Logf("x = %v, cs = %v", x, cs)
+		if len(cs) > 12 {
+			cs = cs[:12]
+		}
+		coeffs := make([]*big.Int, len(cs))
+		for i, c := range cs {
+			coeffs[i] = big.NewInt(int64(c))
+		}
+		// Horner evaluation.
+		r := new(Hamilton)
+		for i := len(coeffs) - 1; i >= 0; i-- {
+			r.Mul(r, x)
+			r.l.l.Add(&r.l.l, coeffs[i])
+		}
+		return x.ReducePowerSeries(coeffs).Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Axes
+
+func TestHamiltonAxisPrimitive(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z, want *Hamilton
+	}{
+		{h(7, 2, 4, 6), h(0, 1, 2, 3)},
+		{h(-1, 0, -5, 0), h(0, 0, -1, 0)},
+		{h(0, 6, -9, 15), h(0, 2, -3, 5)},
+		{h(3, 1, 1, 1), h(0, 1, 1, 1)},
+	}
+	for _, test := range tests {
+		if got, ok := test.z.AxisPrimitive(); !ok || !got.Equals(test.want) {
+			t.Errorf("AxisPrimitive(%v) = %v, %v, want %v", test.z, got, ok, test.want)
+		}
+	}
+	if got, ok := h(5, 0, 0, 0).AxisPrimitive(); ok {
+		t.Errorf("AxisPrimitive((5+0i+0j+0k)) = %v, true, want false", got)
+	}
+}
+
+// Rotations
+
+func TestHamiltonRotate(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z, v, want *Hamilton
+	}{
+		{h(1, 0, 0, 0), h(0, 1, 2, 3), h(0, 1, 2, 3)},
+		{h(1, 0, 0, 1), h(0, 1, 0, 0), h(0, 0, 2, 0)},
+		{h(0, 0, 0, 1), h(0, 1, 2, 3), h(0, -1, -2, 3)},
+		{h(1, 1, 1, 1), h(7, 1, 0, 0), h(0, 0, 4, 0)},
+	}
+	for _, test := range tests {
+		if got := test.z.Rotate(test.v); !got.Equals(test.want) {
+			t.Errorf("Rotate(%v, %v) = %v, want %v", test.z, test.v, got, test.want)
+		}
+	}
+}
+
+func TestHamiltonRotateExact(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z, v, want *Hamilton
+		ok         bool
+	}{
+		{h(0, 0, 0, 1), h(0, 1, 2, 3), h(0, -1, -2, 3), true},
+		{h(1, 0, 0, 1), h(0, 1, 0, 0), h(0, 0, 1, 0), true},
+		{h(1, 1, 1, 1), h(0, 1, 0, 0), h(0, 0, 1, 0), true},
+		{h(1, 0, 0, 1), h(0, 1, 0, 1), h(0, 0, 1, 1), true},
+		{h(1, 2, 0, 0), h(0, 0, 1, 0), nil, false},
+	}
+	for _, test := range tests {
+		got, ok := test.z.RotateExact(test.v)
+		if ok != test.ok || (ok && !got.Equals(test.want)) {
+			t.Errorf("RotateExact(%v, %v) = %v, %v, want %v, %v", test.z, test.v, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestHamiltonRotateUnitQuad(t *testing.T) {
+	f := func(v *Hamilton, n uint8) bool {
+		// t.Logf("v = %v, n = %v", v, n)
+		zero, one := new(big.Int), big.NewInt(1)
+		units := []*Hamilton{
+			NewHamilton(one, zero, zero, zero),
+			NewHamilton(zero, one, zero, zero),
+			NewHamilton(zero, zero, one, zero),
+			NewHamilton(zero, zero, zero, one),
+		}
+		z := units[n%4]
+		p, ok := z.RotateExact(v)
+		w := new(Hamilton).Set(v)
+		w.l.l.SetInt64(0)
+		return ok && p.Equals(z.Rotate(v)) && p.Quad().Cmp(w.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonRotationMatrixIdentity(t *testing.T) {
+	zero := new(big.Int)
+	z := NewHamilton(big.NewInt(1), zero, zero, zero)
+	m := z.RotationMatrix()
+	for i := range m {
+		for j := range m[i] {
+			want := int64(0)
+			if i == j {
+				want = 1
+			}
+			if m[i][j].Int64() != want {
+				t.Errorf("RotationMatrix(%v)[%v][%v] = %v, want %v", z, i, j, m[i][j], want)
+			}
+		}
+	}
+}
+
+func TestHamiltonRotationMatrixRotate(t *testing.T) {
+	f := func(z, v *Hamilton) bool {
+		// t.Logf("z = %v, v = %v", z, v)
+		m := z.RotationMatrix()
+		_, b, c, d := v.Cartesian()
+		_, e, f, g := z.Rotate(v).Cartesian()
+		for i, want := range []*big.Int{e, f, g} {
+			got := new(big.Int)
+			for j, x := range []*big.Int{b, c, d} {
+				got.Add(got, new(big.Int).Mul(m[i][j], x))
+			}
+			if got.Cmp(want) != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonRotateAll(t *testing.T) {
+	f := func(z, x, y *Hamilton) bool {
+		// t.Logf("z = %v, x = %v, y = %v", z, x, y)
+		points := []*Hamilton{x, y, new(Hamilton)}
+		rotated, scale := z.RotateAll(points)
+		if scale.Cmp(z.Quad()) != 0 || len(rotated) != len(points) {
+			return false
+		}
+		for i, v := range points {
+			if !rotated[i].Equals(z.Rotate(v)) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func BenchmarkHamiltonRotateAll(b *testing.B) {
+	z := NewHamilton(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4))
+	points := make([]*Hamilton, 1000)
+	for i := range points {
+		n := int64(i)
+		points[i] = NewHamilton(new(big.Int), big.NewInt(n), big.NewInt(-n), big.NewInt(2*n))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		z.RotateAll(points)
+	}
+}
+
+// Sums
+
+func TestHamiltonSum(t *testing.T) {
+	f := func(x, y, w *Hamilton) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(Hamilton).Sum(x, y, w)
+		r := new(Hamilton).Add(new(Hamilton).Add(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if z := new(Hamilton).Sum(); !z.Equals(new(Hamilton)) {
+		t.Errorf("Sum() = %v, want zero", z)
+	}
+}
+
+// Products
+
+func TestHamiltonProd(t *testing.T) {
+	f := func(x, y, w *Hamilton) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(Hamilton).Prod(x, y, w)
+		r := new(Hamilton).Mul(new(Hamilton).Mul(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	one := NewHamilton(big.NewInt(1), new(big.Int), new(big.Int), new(big.Int))
+	if z := new(Hamilton).Prod(); !z.Equals(one) {
+		t.Errorf("Prod() = %v, want %v", z, one)
+	}
+}
+
+// Inverses
+
+func TestHamiltonInv(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		y, want *Hamilton
+		err     error
+	}{
+		{h(1, 0, 0, 0), h(1, 0, 0, 0), nil},
+		{h(0, 1, 0, 0), h(0, -1, 0, 0), nil},
+		{h(0, 0, -1, 0), h(0, 0, 1, 0), nil},
+		{h(0, 0, 0, 1), h(0, 0, 0, -1), nil},
+		{h(0, 0, 0, 0), nil, ErrZeroDivisor},
+		{h(1, 1, 1, 1), nil, ErrNotIntegral},
+		{h(0, 3, 0, 0), nil, ErrNotIntegral},
+	}
+	for _, test := range tests {
+		z := h(7, 7, 7, 7)
+		err := z.Inv(test.y)
+		if err != test.err {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, err, test.err)
+			continue
+		}
+		if err != nil {
+			if !z.Equals(h(7, 7, 7, 7)) {
+				t.Errorf("Inv(%v) modified z to %v", test.y, z)
+			}
+			continue
+		}
+		if !z.Equals(test.want) {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, z, test.want)
+		}
+	}
+}
+
+// Division
+
+func TestHamiltonQuoRem(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if zero := new(Hamilton); y.Equals(zero) {
+			return true
+		}
+		q, r := new(Hamilton).QuoRem(x, y, new(Hamilton))
+		l := new(Hamilton).Add(new(Hamilton).Mul(q, y), r)
+		return l.Equals(x) && r.Quad().Cmp(y.Quad()) <= 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonQuoRemNearest(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		x, y, q, r *Hamilton
+	}{
+		{h(7, 0, 0, 0), h(2, 0, 0, 0), h(4, 0, 0, 0), h(-1, 0, 0, 0)},
+		{h(0, 0, 0, 5), h(0, 1, 0, 0), h(0, 0, -5, 0), h(0, 0, 0, 0)},
+		{h(3, 1, 4, 1), h(1, 1, 0, 0), h(2, -1, 2, 3), h(0, 0, -1, 0)},
+		{h(1, 1, 1, 1), h(2, 0, 0, 0), h(1, 1, 1, 1), h(-1, -1, -1, -1)},
+	}
+	for _, test := range tests {
+		q, r := new(Hamilton).QuoRem(test.x, test.y, new(Hamilton))
+		if !q.Equals(test.q) || !r.Equals(test.r) {
+			t.Errorf("QuoRem(%v, %v) = %v, %v, want %v, %v", test.x, test.y, q, r, test.q, test.r)
+		}
+	}
+}
+
+// Greatest common divisors
+
+func TestHamiltonGCD(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	g := h(1, 2, 0, 0)
+	var tests = []struct {
+		x, y, right, left *Hamilton
+	}{
+		{h(0, 0, 0, 0), h(0, 0, 0, 0), h(0, 0, 0, 0), h(0, 0, 0, 0)},
+		{h(0, 0, -3, 0), h(0, 0, 0, 0), h(3, 0, 0, 0), h(3, 0, 0, 0)},
+		{h(0, 0, 0, 0), h(0, 1, 0, 1), h(1, 0, 1, 0), h(1, 0, 1, 0)},
+		{h(5, 0, 0, 0), g, h(2, -1, 0, 0), h(2, -1, 0, 0)},
+		{
+			new(Hamilton).Mul(h(3, 0, 0, 0), g),
+			new(Hamilton).Mul(h(1, 0, 1, 0), g),
+			h(2, -1, 0, 0),
+			h(1, 0, 0, 0),
+		},
+		{
+			new(Hamilton).Mul(g, h(0, 1, 2, 2)),
+			new(Hamilton).Mul(g, h(2, 0, 0, 1)),
+			h(1, 0, 0, 0),
+			h(2, -1, 0, 0),
+		},
+	}
+	for _, test := range tests {
+		if got := new(Hamilton).GCDRight(test.x, test.y); got == nil || !got.Equals(test.right) {
+			t.Errorf("GCDRight(%v, %v) = %v, want %v", test.x, test.y, got, test.right)
+		}
+		if got := new(Hamilton).GCDLeft(test.x, test.y); got == nil || !got.Equals(test.left) {
+			t.Errorf("GCDLeft(%v, %v) = %v, want %v", test.x, test.y, got, test.left)
+		}
+	}
+	// The left ideal spanned by 2 and 1+i+j+k is not principal.
+	x, y := h(2, 0, 0, 0), h(1, 1, 1, 1)
+	if got := new(Hamilton).GCDRight(x, y); got != nil {
+		t.Errorf("GCDRight(%v, %v) = %v, want nil", x, y, got)
+	}
+}
+
+func TestHamiltonGCDRightDivides(t *testing.T) {
+	f := func(x, y, w *Hamilton) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		a := new(Hamilton).Mul(x, w)
+		b := new(Hamilton).Mul(y, w)
+		g := new(Hamilton).GCDRight(a, b)
+		if g == nil {
+			return true
+		}
+		zero := new(Hamilton)
+		for _, c := range []*Hamilton{a, b} {
+			if _, r := new(Hamilton).QuoRem(c, g, new(Hamilton)); !r.Equals(zero) {
+				return false
+			}
+		}
+		_, r := new(Hamilton).QuoRem(g, w, new(Hamilton))
+		return r.Equals(zero)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonExactQuo(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if zero := new(Hamilton); y.Equals(zero) {
+			return true
+		}
+		p := new(Hamilton).Mul(x, y)
+		q, ok := new(Hamilton).ExactQuo(p, y)
+		return ok && q.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		x, y *Hamilton
+	}{
+		{h(1, 0, 0, 0), h(2, 0, 0, 0)},
+		{h(1, 1, 1, 1), h(2, 0, 0, 0)},
+		{h(3, 1, 4, 1), h(1, 1, 0, 0)},
+	}
+	for _, test := range tests {
+		z := h(7, 7, 7, 7)
+		if q, ok := z.ExactQuo(test.x, test.y); ok || q != nil || !z.Equals(h(7, 7, 7, 7)) {
+			t.Errorf("ExactQuo(%v, %v) = %v, %v, want nil, false", test.x, test.y, q, ok)
+		}
+	}
+	// The quotient is on the left of y: (i+j)(1+i) = -1+i+j-k.
+	x, y := h(-1, 1, 1, -1), h(1, 1, 0, 0)
+	if q, ok := new(Hamilton).ExactQuo(x, y); !ok || !q.Equals(h(0, 1, 1, 0)) {
+		t.Errorf("ExactQuo(%v, %v) = %v, %v, want (0+1i+1j+0k), true", x, y, q, ok)
+	}
+}
+
+// Norms
+
+func TestHamiltonAbs(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z     *Hamilton
+		abs   int64
+		exact bool
+	}{
+		{h(0, 0, 0, 0), 0, true},
+		{h(1, 1, 1, 1), 2, true},
+		{h(1, -2, 2, 4), 5, true},
+		{h(1, 1, 0, 0), 1, false},
+		{h(3, 3, 3, 0), 5, false},
+	}
+	for _, test := range tests {
+		if got := test.z.Abs(); got.Int64() != test.abs {
+			t.Errorf("Abs(%v) = %v, want %v", test.z, got, test.abs)
+		}
+		if got := test.z.NormIsExact(); got != test.exact {
+			t.Errorf("NormIsExact(%v) = %v, want %v", test.z, got, test.exact)
+		}
+	}
+}
+
+func TestHamiltonAbsFloor(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		a := x.Abs()
+		b := new(big.Int).Add(a, big.NewInt(1))
+		quad := x.Quad()
+		return a.Mul(a, a).Cmp(quad) <= 0 && b.Mul(b, b).Cmp(quad) > 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Cross products
+
+func TestHamiltonCross(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		x, y, want *Hamilton
+	}{
+		{h(0, 1, 0, 0), h(0, 0, 1, 0), h(0, 0, 0, 1)},
+		{h(0, 0, 1, 0), h(0, 0, 0, 1), h(0, 1, 0, 0)},
+		{h(0, 0, 0, 1), h(0, 1, 0, 0), h(0, 0, 1, 0)},
+		{h(5, 1, 2, 3), h(-7, 4, 5, 6), h(0, -3, 6, -3)},
+		{h(0, 2, 2, 2), h(0, 1, 1, 1), h(0, 0, 0, 0)},
+	}
+	for _, test := range tests {
+		if got := new(Hamilton).Cross(test.x, test.y); !got.Equals(test.want) {
+			t.Errorf("Cross(%v, %v) = %v, want %v", test.x, test.y, got, test.want)
+		}
+	}
+}
+
+func TestHamiltonCrossMul(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a := new(Hamilton).Set(x)
+		a.l.l.SetInt64(0)
+		b := new(Hamilton).Set(y)
+		b.l.l.SetInt64(0)
+		p := new(Hamilton).Mul(a, b)
+		p.l.l.SetInt64(0)
+		c := new(Hamilton).Cross(x, y)
+		return c.Equals(p) && c.Dot(a).Sign() == 0 && c.Dot(b).Sign() == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Matrices
+
+// det4 returns the determinant of m by cofactor expansion along the first row.
+func det4(m [4][4]*big.Int) *big.Int {
+	det := new(big.Int)
+	for j := 0; j < 4; j++ {
+		var minor [3][3]*big.Int
+		for r := 1; r < 4; r++ {
+			c := 0
+			for k := 0; k < 4; k++ {
+				if k != j {
+					minor[r-1][c] = m[r][k]
+					c++
+				}
+			}
+		}
+		term := new(big.Int).Mul(m[0][j], det3(minor))
+		if j%2 == 0 {
+			det.Add(det, term)
+		} else {
+			det.Sub(det, term)
+		}
+	}
+	return det
+}
+
+// det3 returns the determinant of m by the rule of Sarrus.
+func det3(m [3][3]*big.Int) *big.Int {
+	det := new(big.Int)
+	temp := new(big.Int)
+	for j := 0; j < 3; j++ {
+		temp.Mul(m[0][j], m[1][(j+1)%3])
+		det.Add(det, temp.Mul(temp, m[2][(j+2)%3]))
+		temp.Mul(m[0][j], m[1][(j+2)%3])
+		det.Sub(det, temp.Mul(temp, m[2][(j+1)%3]))
+	}
+	return det
+}
+
+func TestHamiltonMatrixDet(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		quad := x.Quad()
+		return det4(x.Matrix()).Cmp(quad.Mul(quad, quad)) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonMatrixMul(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		m := x.Matrix()
+		v := make([]*big.Int, 4)
+		v[0], v[1], v[2], v[3] = y.Cartesian()
+		w := make([]*big.Int, 4)
+		w[0], w[1], w[2], w[3] = new(Hamilton).Mul(x, y).Cartesian()
+		for i := range m {
+			e := new(big.Int)
+			for j := range m[i] {
+				e.Add(e, new(big.Int).Mul(m[i][j], v[j]))
+			}
+			if e.Cmp(w[i]) != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonSetMatrix(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		y, ok := new(Hamilton).SetMatrix(x.Matrix())
+		return ok && y.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	m := NewHamilton(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)).Matrix()
+	m[1][2].SetInt64(5)
+	if y, ok := new(Hamilton).SetMatrix(m); ok {
+		t.Errorf("SetMatrix(%v) = %v, true, want false", m, y)
+	}
+}
+
+// Traces
+
+func TestHamiltonTrace(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		one := new(Hamilton).Pow(x, 0)
+		l := new(Hamilton).Add(x, new(Hamilton).Conj(x))
+		r := new(Hamilton).Scal(one, x.Trace())
+		if !l.Equals(r) {
+			return false
+		}
+		// Mul(x, x) - Mul(Trace(x), x) + Quad(x) = 0
+		p := new(Hamilton).Mul(x, x)
+		p.Sub(p, new(Hamilton).Scal(x, x.Trace()))
+		p.Add(p, one.Scal(one, x.Quad()))
+		return p.Equals(new(Hamilton))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Scalar and vector parts
+
+func TestHamiltonUnreal(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		a := new(big.Int).Rsh(x.Trace(), 1)
+		v := x.Unreal()
+		one := new(Hamilton).Pow(x, 0)
+		d := new(Hamilton).Sub(x, v)
+		return v.Trace().Sign() == 0 && d.Equals(one.Scal(one, a))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Real and unreal values
+
+func TestHamiltonIsReal(t *testing.T) {
+	v := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z            *Hamilton
+		real, unreal bool
+	}{
+		{v(0, 0, 0, 0), true, false},
+		{v(3, 0, 0, 0), true, false},
+		{v(0, 0, 0, -2), false, true},
+		{v(0, 5, 0, 0), false, true},
+		{v(1, 0, 0, 1), false, false},
+	}
+	for _, test := range tests {
+		if got := test.z.IsReal(); got != test.real {
+			t.Errorf("IsReal(%v) = %v, want %v", test.z, got, test.real)
+		}
+		if got := test.z.IsUnreal(); got != test.unreal {
+			t.Errorf("IsUnreal(%v) = %v, want %v", test.z, got, test.unreal)
+		}
+	}
+}
+
+// Identities
+
+func TestHamiltonIdentities(t *testing.T) {
+	f := func(x, y *Hamilton) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		zero := new(Hamilton).Set(y).SetZero()
+		one := new(Hamilton).Set(y).SetOne()
+		if !zero.IsZero() || !zero.Equals(new(Hamilton)) || one.IsZero() {
+			return false
+		}
+		if x.IsZero() != x.Equals(zero) {
+			return false
+		}
+		l := new(Hamilton).Mul(x, one)
+		r := new(Hamilton).Mul(one, x)
+		return new(Hamilton).Add(x, zero).Equals(x) && l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Embeddings
+
+func TestHamiltonFromComplex(t *testing.T) {
+	f := func(x, y *Complex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, b := new(Hamilton).FromComplex(x), new(Hamilton).FromComplex(y)
+		if z, ok := a.ToComplex(); !ok || !z.Equals(x) {
+			return false
+		}
+		if a.Quad().Cmp(x.Quad()) != 0 {
+			return false
+		}
+		if !new(Hamilton).Conj(a).Equals(new(Hamilton).FromComplex(new(Complex).Conj(x))) {
+			return false
+		}
+		prod := new(Hamilton).FromComplex(new(Complex).Mul(x, y))
+		return prod.Equals(new(Hamilton).Mul(a, b)) &&
+			new(Hamilton).Commutator(a, b).IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHamiltonToComplex(t *testing.T) {
+	h := func(a, b, c, d int64) *Hamilton {
+		return NewHamilton(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z    *Hamilton
+		want *Complex
+		ok   bool
+	}{
+		{h(1, 2, 0, 0), NewComplex(big.NewInt(1), big.NewInt(2)), true},
+		{h(1, 2, 3, 0), NewComplex(big.NewInt(1), big.NewInt(2)), false},
+		{h(0, 0, 0, -1), new(Complex), false},
+		{h(0, 0, 0, 0), new(Complex), true},
+	}
+	for _, test := range tests {
+		if got, ok := test.z.ToComplex(); !got.Equals(test.want) || ok != test.ok {
+			t.Errorf("ToComplex(%v) = %v, %v, want %v, %v", test.z, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestHamiltonFromComplexNotCentral(t *testing.T) {
+	zero, one := new(big.Int), big.NewInt(1)
+	i := new(Hamilton).FromComplex(NewComplex(zero, one))
+	j := NewHamilton(zero, zero, one, zero)
+	want := NewHamilton(zero, zero, zero, big.NewInt(2))
+	if got := new(Hamilton).Commutator(i, j); !got.Equals(want) {
+		t.Errorf("Commutator(%v, %v) = %v, want %v", i, j, got, want)
+	}
+}
+
+// Conversions
+
+func TestHamiltonFloat64s(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(1), 1024)
+	odd := new(big.Int).Lsh(big.NewInt(1), 53)
+	odd.Add(odd, big.NewInt(1))
+	var tests = []struct {
+		x    *Hamilton
+		want [4]float64
+	}{
+		{new(Hamilton), [4]float64{0, 0, 0, 0}},
+		{NewHamilton(big.NewInt(1), big.NewInt(-2), big.NewInt(3), big.NewInt(-4)),
+			[4]float64{1, -2, 3, -4}},
+		{NewHamilton(odd, big.NewInt(0), huge, new(big.Int).Neg(huge)),
+			[4]float64{1 << 53, 0, math.Inf(+1), math.Inf(-1)}},
+	}
+	for _, test := range tests {
+		if got := test.x.Float64s(); got != test.want {
+			t.Errorf("Float64s(%v) = %v, want %v", test.x, got, test.want)
+		}
+	}
+}
+
+func TestHamiltonFloat64sComplex128(t *testing.T) {
+	f := func(x *Hamilton) bool {
+		// t.Logf("x = %v", x)
+		v := x.Float64s()
+		return complex(v[0], v[1]) == x.l.Complex128() &&
+			complex(v[2], v[3]) == x.r.Complex128()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"strings"
 )
 
+var symbInfra = [2]string{"", "α"}
+
 // An Infra represents an integral infra number.
 type Infra struct {
 	l, r big.Int
@@ -21,24 +23,60 @@ func (z *Infra) Cartesian() (a, b *big.Int) {
 	return &z.l, &z.r
 }
 
+// Components returns the two Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *Infra) Components() []*big.Int {
+	a, b := z.Cartesian()
+	return []*big.Int{a, b}
+}
+
 // String returns the string version of a Infra value.
 //
 // If z corresponds to a + bε, then the string is "(a+bα)", similar to
 // complex128 values.
 func (z *Infra) String() string {
+	return z.StringWith(symbInfra)
+}
+
+// StringWith returns the string representation of z with the units written as
+// symb, where symb[0] is the unit of the real part. The layout is the same as
+// that of String.
+func (z *Infra) StringWith(symb [2]string) string {
 	a := make([]string, 5)
 	a[0] = "("
-	a[1] = fmt.Sprintf("%v", &z.l)
+	a[1] = fmt.Sprintf("%v%s", &z.l, symb[0])
 	if z.r.Sign() == -1 {
 		a[2] = fmt.Sprintf("%v", &z.r)
 	} else {
 		a[2] = fmt.Sprintf("+%v", &z.r)
 	}
-	a[3] = "α"
+	a[3] = symb[1]
 	a[4] = ")"
 	return strings.Join(a, "")
 }
 
+// Compact returns a compact string representation of z. It is like String, but
+// zero terms are omitted and unit coefficients are collapsed, so that the
+// string is "(1)" or "(1-α)" instead of listing every component. The zero
+// value gives "(0)".
+func (z *Infra) Compact() string {
+	return "(" + compactTerms([]*big.Int{&z.l, &z.r}, symbInfra[:]) + ")"
+}
+
+// SetString sets z equal to the value of s, and returns z and a boolean
+// indicating success. The string s must be of the form "(a+bα)", where omitted
+// terms are zero. If SetString fails, the value of z is undefined but the
+// returned value is nil.
+func (z *Infra) SetString(s string) (*Infra, bool) {
+	v, ok := parseTerms(s, symbInfra[:])
+	if !ok {
+		return nil, false
+	}
+	z.l.Set(v[0])
+	z.r.Set(v[1])
+	return z, true
+}
+
 // Equals returns true if y and z are equal.
 func (z *Infra) Equals(y *Infra) bool {
 	if z.l.Cmp(&y.l) != 0 || z.r.Cmp(&y.r) != 0 {
@@ -47,6 +85,17 @@ func (z *Infra) Equals(y *Infra) bool {
 	return true
 }
 
+// IsReal returns true if the non-real component of z is zero.
+func (z *Infra) IsReal() bool {
+	return z.r.Sign() == 0
+}
+
+// IsUnreal returns true if the real part of z is zero and the other component
+// is not, so that z is a nonzero pure unreal value.
+func (z *Infra) IsUnreal() bool {
+	return z.l.Sign() == 0 && z.r.Sign() != 0
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Infra) Set(y *Infra) *Infra {
 	z.l.Set(&y.l)
@@ -54,6 +103,31 @@ func (z *Infra) Set(y *Infra) *Infra {
 	return z
 }
 
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *Infra) Clone() *Infra {
+	return new(Infra).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *Infra) IsZero() bool {
+	return z.l.Sign() == 0 && z.r.Sign() == 0
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *Infra) SetZero() *Infra {
+	z.l.SetInt64(0)
+	z.r.SetInt64(0)
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *Infra) SetOne() *Infra {
+	z.l.SetInt64(1)
+	z.r.SetInt64(0)
+	return z
+}
+
 // NewInfra returns a pointer to the Infra value a+bα.
 func NewInfra(a, b *big.Int) *Infra {
 	z := new(Infra)
@@ -97,6 +171,16 @@ func (z *Infra) Sub(x, y *Infra) *Infra {
 	return z
 }
 
+// Sum sets z equal to the sum of xs, and returns z. If xs is empty, then z is
+// zero.
+func (z *Infra) Sum(xs ...*Infra) *Infra {
+	s := new(Infra)
+	for _, x := range xs {
+		s.Add(s, x)
+	}
+	return z.Set(s)
+}
+
 // Mul sets z to the product of x and y, and returns z.
 //
 // The multiplication rule is:
@@ -130,6 +214,19 @@ func (z *Infra) IsZeroDiv() bool {
 	return z.l.Cmp(zero) == 0
 }
 
+// NilpotencyIndex returns the smallest positive n such that z raised to the
+// nth power vanishes, or 0 if there is no such n. Since Mul(α, α) = 0, the
+// index is 1 for zero, 2 for any other zero divisor, and 0 otherwise.
+func (z *Infra) NilpotencyIndex() int {
+	switch {
+	case z.IsZero():
+		return 1
+	case z.IsZeroDiv():
+		return 2
+	}
+	return 0
+}
+
 // Quo sets z equal to the quotient of x and y, and returns z.
 func (z *Infra) Quo(x, y *Infra) *Infra {
 	if y.IsZeroDiv() {
@@ -143,6 +240,79 @@ func (z *Infra) Quo(x, y *Infra) *Infra {
 	return z
 }
 
+// ExactQuo sets z equal to the quotient of x and y, as given by Quo, and
+// returns z and true, if Quad(y) divides both components of
+// 		Mul(x, Conj(y))
+// so that Mul(y, z) = x. Otherwise z is not modified, and ExactQuo returns nil
+// and false. If y is a zero divisor, then a quotient is not unique even when
+// one exists, so ExactQuo returns nil and false instead of panicking.
+func (z *Infra) ExactQuo(x, y *Infra) (*Infra, bool) {
+	if y.IsZeroDiv() {
+		return nil, false
+	}
+	p := new(Infra).Conj(y)
+	p.Mul(x, p)
+	if !quoExact([]*big.Int{&p.l, &p.r}, y.Quad()) {
+		return nil, false
+	}
+	return z.Set(p), true
+}
+
+// Inv sets z equal to the inverse of y, and returns nil. If y = a+bα, then the
+// inverse is
+// 		Conj(y) / Quad(y) = 1/a - (b/Mul(a, a))α
+// which is integral only if a is ±1. If y is a zero divisor, then Inv returns
+// ErrZeroDivisor; if the inverse is not integral, then Inv returns
+// ErrNotIntegral. In both cases z is not modified.
+func (z *Infra) Inv(y *Infra) error {
+	if y.IsZeroDiv() {
+		return ErrZeroDivisor
+	}
+	c := new(Infra).Conj(y)
+	if !quoExact([]*big.Int{&c.l, &c.r}, y.Quad()) {
+		return ErrNotIntegral
+	}
+	z.Set(c)
+	return nil
+}
+
+// QuoRem sets z equal to the quotient of x and y, as given by Quo, and returns
+// z, the remainder
+// 		Sub(x, Mul(z, y))
+// and true if the remainder is zero, so that the division is exact. If y is a
+// zero divisor, then QuoRem panics.
+func (z *Infra) QuoRem(x, y *Infra) (q, r *Infra, ok bool) {
+	a := new(Infra).Set(x)
+	b := new(Infra).Set(y)
+	z.Quo(a, b)
+	r = new(Infra).Mul(z, b)
+	r.Sub(a, r)
+	return z, r, r.Equals(new(Infra))
+}
+
+// Pow sets z equal to x raised to the nth power, and returns z. Since α is
+// nilpotent, the closed form
+// 		Pow(a+bα, n) = Pow(a, n) + Mul(n, Pow(a, n-1), b)α
+// is used. If n is zero, then z is one. If n is negative, then z is the
+// quotient of one and x raised to the -n power, as given by Quo, so Pow panics
+// if x is a zero divisor.
+func (z *Infra) Pow(x *Infra, n int) *Infra {
+	if n < 0 {
+		p := new(Infra).Pow(x, -n)
+		return z.Quo(NewInfra(big.NewInt(1), new(big.Int)), p)
+	}
+	if n == 0 {
+		z.l.SetInt64(1)
+		z.r.SetInt64(0)
+		return z
+	}
+	a := new(big.Int).Exp(&x.l, big.NewInt(int64(n-1)), nil)
+	z.r.Mul(&x.r, a)
+	z.r.Mul(&z.r, big.NewInt(int64(n)))
+	z.l.Mul(&x.l, a)
+	return z
+}
+
 // Generate returns a random Infra value for quick.Check testing.
 func (z *Infra) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomInfra := &Infra{
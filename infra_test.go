@@ -299,3 +299,299 @@ func TestInfraComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Parsing
+
+func TestInfraStringSetString(t *testing.T) {
+	f := func(x *Infra) bool {
+		// t.Logf("x = %v", x)
+		l, ok := new(Infra).SetString(x.String())
+		return ok && l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraSetString(t *testing.T) {
+	var tests = []struct {
+		s    string
+		want *Infra
+	}{
+		{"(3+4α)", NewInfra(big.NewInt(3), big.NewInt(4))},
+		{"(3-4α)", NewInfra(big.NewInt(3), big.NewInt(-4))},
+		{"(-α)", NewInfra(big.NewInt(0), big.NewInt(-1))},
+		{"(7)", NewInfra(big.NewInt(7), big.NewInt(0))},
+	}
+	for _, test := range tests {
+		if got, ok := new(Infra).SetString(test.s); !ok || !got.Equals(test.want) {
+			t.Errorf("SetString(%q) = %v, %v, want %v", test.s, got, ok, test.want)
+		}
+	}
+	for _, s := range []string{"", "(3+4ε)", "(3+4α+5α)", "(3+-4α)"} {
+		if _, ok := new(Infra).SetString(s); ok {
+			t.Errorf("SetString(%q) succeeded, want failure", s)
+		}
+	}
+}
+
+// Division
+
+func TestInfraQuoRemExact(t *testing.T) {
+	f := func(x, y *Infra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p := new(Infra).Mul(x, y)
+		q, r, ok := new(Infra).QuoRem(p, y)
+		return ok && q.Equals(x) && r.Equals(new(Infra))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraQuoRem(t *testing.T) {
+	var tests = []struct {
+		x, y, q, r *Infra
+		ok         bool
+	}{
+		{
+			NewInfra(big.NewInt(6), big.NewInt(8)),
+			NewInfra(big.NewInt(2), big.NewInt(1)),
+			NewInfra(big.NewInt(3), big.NewInt(2)),
+			NewInfra(big.NewInt(0), big.NewInt(1)),
+			false,
+		},
+		{
+			NewInfra(big.NewInt(7), big.NewInt(0)),
+			NewInfra(big.NewInt(2), big.NewInt(0)),
+			NewInfra(big.NewInt(3), big.NewInt(0)),
+			NewInfra(big.NewInt(1), big.NewInt(0)),
+			false,
+		},
+		{
+			NewInfra(big.NewInt(6), big.NewInt(7)),
+			NewInfra(big.NewInt(3), big.NewInt(2)),
+			NewInfra(big.NewInt(2), big.NewInt(1)),
+			NewInfra(big.NewInt(0), big.NewInt(0)),
+			true,
+		},
+	}
+	for _, test := range tests {
+		q, r, ok := new(Infra).QuoRem(test.x, test.y)
+		if !q.Equals(test.q) || !r.Equals(test.r) || ok != test.ok {
+			t.Errorf("QuoRem(%v, %v) = %v, %v, %v, want %v, %v, %v",
+				test.x, test.y, q, r, ok, test.q, test.r, test.ok)
+		}
+	}
+}
+
+// Powers
+
+func TestInfraPowNaive(t *testing.T) {
+	f := func(x *Infra, n uint8) bool {
+		// t.Logf("x = %v, n = %v", x, n)
+		n = n % 16
+		r := NewInfra(big.NewInt(1), new(big.Int))
+		for i := uint8(0); i < n; i++ {
+			r.Mul(r, x)
+		}
+		return new(Infra).Pow(x, int(n)).Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraPow(t *testing.T) {
+	var tests = []struct {
+		x    *Infra
+		n    int
+		want *Infra
+	}{
+		{NewInfra(big.NewInt(3), big.NewInt(5)), 0, NewInfra(big.NewInt(1), big.NewInt(0))},
+		{NewInfra(big.NewInt(0), big.NewInt(5)), 0, NewInfra(big.NewInt(1), big.NewInt(0))},
+		{NewInfra(big.NewInt(0), big.NewInt(5)), 1, NewInfra(big.NewInt(0), big.NewInt(5))},
+		{NewInfra(big.NewInt(0), big.NewInt(5)), 2, NewInfra(big.NewInt(0), big.NewInt(0))},
+		{NewInfra(big.NewInt(2), big.NewInt(1)), 3, NewInfra(big.NewInt(8), big.NewInt(12))},
+		{NewInfra(big.NewInt(-1), big.NewInt(1)), -1, NewInfra(big.NewInt(-1), big.NewInt(-1))},
+		{NewInfra(big.NewInt(1), big.NewInt(2)), -3, NewInfra(big.NewInt(1), big.NewInt(-6))},
+	}
+	for _, test := range tests {
+		if got := new(Infra).Pow(test.x, test.n); !got.Equals(test.want) {
+			t.Errorf("Pow(%v, %v) = %v, want %v", test.x, test.n, got, test.want)
+		}
+	}
+}
+
+// Sums
+
+func TestInfraSum(t *testing.T) {
+	f := func(x, y, w *Infra) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(Infra).Sum(x, y, w)
+		r := new(Infra).Add(new(Infra).Add(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if z := new(Infra).Sum(); !z.Equals(new(Infra)) {
+		t.Errorf("Sum() = %v, want zero", z)
+	}
+}
+
+// Inverses
+
+func TestInfraInv(t *testing.T) {
+	d := func(a, b int64) *Infra {
+		return NewInfra(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		y, want *Infra
+		err     error
+	}{
+		{d(1, 0), d(1, 0), nil},
+		{d(1, 5), d(1, -5), nil},
+		{d(-1, 3), d(-1, -3), nil},
+		{d(0, 0), nil, ErrZeroDivisor},
+		{d(0, 4), nil, ErrZeroDivisor},
+		{d(2, 0), nil, ErrNotIntegral},
+		{d(3, 9), nil, ErrNotIntegral},
+	}
+	for _, test := range tests {
+		z := d(7, 7)
+		err := z.Inv(test.y)
+		if err != test.err {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, err, test.err)
+			continue
+		}
+		if err != nil {
+			if !z.Equals(d(7, 7)) {
+				t.Errorf("Inv(%v) modified z to %v", test.y, z)
+			}
+			continue
+		}
+		if !z.Equals(test.want) {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, z, test.want)
+		}
+	}
+}
+
+// Exact division
+
+func TestInfraExactQuo(t *testing.T) {
+	f := func(x, y *Infra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if y.IsZeroDiv() {
+			return true
+		}
+		q, ok := new(Infra).ExactQuo(new(Infra).Mul(x, y), y)
+		return ok && q.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	d := func(a, b int64) *Infra {
+		return NewInfra(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		x, y *Infra
+	}{
+		{d(1, 0), d(2, 0)},
+		{d(6, 8), d(2, 1)},
+		{d(0, 3), d(0, 1)},
+	}
+	for _, test := range tests {
+		z := d(7, 7)
+		if q, ok := z.ExactQuo(test.x, test.y); ok || q != nil || !z.Equals(d(7, 7)) {
+			t.Errorf("ExactQuo(%v, %v) = %v, %v, want nil, false", test.x, test.y, q, ok)
+		}
+	}
+	x, y := d(6, 7), d(2, 1)
+	if q, ok := new(Infra).ExactQuo(x, y); !ok || !q.Equals(d(3, 2)) {
+		t.Errorf("ExactQuo(%v, %v) = %v, %v, want (3+2α), true", x, y, q, ok)
+	}
+}
+
+// Real and unreal values
+
+func TestInfraIsReal(t *testing.T) {
+	v := func(a, b int64) *Infra {
+		return NewInfra(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		z            *Infra
+		real, unreal bool
+	}{
+		{v(0, 0), true, false},
+		{v(3, 0), true, false},
+		{v(0, -2), false, true},
+		{v(0, 5), false, true},
+		{v(1, 1), false, false},
+	}
+	for _, test := range tests {
+		if got := test.z.IsReal(); got != test.real {
+			t.Errorf("IsReal(%v) = %v, want %v", test.z, got, test.real)
+		}
+		if got := test.z.IsUnreal(); got != test.unreal {
+			t.Errorf("IsUnreal(%v) = %v, want %v", test.z, got, test.unreal)
+		}
+	}
+}
+
+// Identities
+
+func TestInfraIdentities(t *testing.T) {
+	f := func(x, y *Infra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		zero := new(Infra).Set(y).SetZero()
+		one := new(Infra).Set(y).SetOne()
+		if !zero.IsZero() || !zero.Equals(new(Infra)) || one.IsZero() {
+			return false
+		}
+		if x.IsZero() != x.Equals(zero) {
+			return false
+		}
+		l := new(Infra).Mul(x, one)
+		r := new(Infra).Mul(one, x)
+		return new(Infra).Add(x, zero).Equals(x) && l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Nilpotents
+
+func TestInfraNilpotencyIndex(t *testing.T) {
+	var tests = []struct {
+		x    *Infra
+		want int
+	}{
+		{NewInfra(big.NewInt(0), big.NewInt(0)), 1},
+		{NewInfra(big.NewInt(0), big.NewInt(1)), 2},
+		{NewInfra(big.NewInt(0), big.NewInt(-7)), 2},
+		{NewInfra(big.NewInt(1), big.NewInt(0)), 0},
+		{NewInfra(big.NewInt(-3), big.NewInt(5)), 0},
+	}
+	for _, test := range tests {
+		if got := test.x.NilpotencyIndex(); got != test.want {
+			t.Errorf("NilpotencyIndex(%v) = %d, want %d", test.x, got, test.want)
+		}
+	}
+}
+
+func TestInfraNilpotencyIndexPow(t *testing.T) {
+	f := func(x *Infra) bool {
+		// t.Logf("x = %v", x)
+		n := x.NilpotencyIndex()
+		if n == 0 {
+			return !new(Infra).Pow(x, 2).IsZero()
+		}
+		return new(Infra).Pow(x, n).IsZero() &&
+			(n == 1 || !new(Infra).Pow(x, n-1).IsZero())
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
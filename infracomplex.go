@@ -23,17 +23,31 @@ func (z *InfraComplex) Cartesian() (*big.Int, *big.Int, *big.Int, *big.Int) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// Components returns the four Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *InfraComplex) Components() []*big.Int {
+	a, b, c, d := z.Cartesian()
+	return []*big.Int{a, b, c, d}
+}
+
 // String returns the string representation of an InfraComplex value.
 //
 // If z corresponds to a + bi + cβ + dγ, then the string is"(a+bi+cβ+dγ)",
 // similar to complex128 values.
 func (z *InfraComplex) String() string {
+	return z.StringWith(symbInfraComplex)
+}
+
+// StringWith returns the string representation of z with the units written as
+// symb, where symb[0] is the unit of the real part. The layout is the same as
+// that of String.
+func (z *InfraComplex) StringWith(symb [4]string) string {
 	v := make([]*big.Int, 4)
 	v[0], v[1] = z.l.Cartesian()
 	v[2], v[3] = z.r.Cartesian()
 	a := make([]string, 9)
 	a[0] = "("
-	a[1] = fmt.Sprintf("%v", v[0])
+	a[1] = fmt.Sprintf("%v%s", v[0], symb[0])
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
 		if v[i].Sign() < 0 {
@@ -41,13 +55,39 @@ func (z *InfraComplex) String() string {
 		} else {
 			a[j] = fmt.Sprintf("+%v", v[i])
 		}
-		a[j+1] = symbInfraComplex[i]
+		a[j+1] = symb[i]
 		i++
 	}
 	a[8] = ")"
 	return strings.Join(a, "")
 }
 
+// Compact returns a compact string representation of z. It is like String, but
+// zero terms are omitted and unit coefficients are collapsed, so that the
+// string is "(1)" or "(1-γ)" instead of listing every component. The zero
+// value gives "(0)".
+func (z *InfraComplex) Compact() string {
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return "(" + compactTerms(v, symbInfraComplex[:]) + ")"
+}
+
+// SetString sets z equal to the value of s, and returns z and a boolean
+// indicating success. The string s must be of the form "(a+bi+cβ+dγ)", where
+// omitted terms are zero. If SetString fails, the value of z is undefined but
+// the returned value is nil.
+func (z *InfraComplex) SetString(s string) (*InfraComplex, bool) {
+	v, ok := parseTerms(s, symbInfraComplex[:])
+	if !ok {
+		return nil, false
+	}
+	z.l.l.Set(v[0])
+	z.l.r.Set(v[1])
+	z.r.l.Set(v[2])
+	z.r.r.Set(v[3])
+	return z, true
+}
+
 // Equals returns true if y and z are equal.
 func (z *InfraComplex) Equals(y *InfraComplex) bool {
 	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
@@ -56,6 +96,17 @@ func (z *InfraComplex) Equals(y *InfraComplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *InfraComplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Complex))
+}
+
+// IsUnreal returns true if the real part of z is zero and at least one of the
+// other components is not, so that z is a nonzero pure unreal value.
+func (z *InfraComplex) IsUnreal() bool {
+	return z.l.l.Sign() == 0 && !z.IsReal()
+}
+
 // Set sets z equal to y, and returns z.
 func (z *InfraComplex) Set(y *InfraComplex) *InfraComplex {
 	z.l.Set(&y.l)
@@ -63,6 +114,31 @@ func (z *InfraComplex) Set(y *InfraComplex) *InfraComplex {
 	return z
 }
 
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *InfraComplex) Clone() *InfraComplex {
+	return new(InfraComplex).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *InfraComplex) IsZero() bool {
+	return z.l.IsZero() && z.r.IsZero()
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *InfraComplex) SetZero() *InfraComplex {
+	z.l.SetZero()
+	z.r.SetZero()
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *InfraComplex) SetOne() *InfraComplex {
+	z.l.SetOne()
+	z.r.SetZero()
+	return z
+}
+
 // NewInfraComplex returns a pointer to an InfraComplex value made from four
 // given pointers to big.Int values.
 func NewInfraComplex(a, b, c, d *big.Int) *InfraComplex {
@@ -109,6 +185,16 @@ func (z *InfraComplex) Sub(x, y *InfraComplex) *InfraComplex {
 	return z
 }
 
+// Sum sets z equal to the sum of xs, and returns z. If xs is empty, then z is
+// zero.
+func (z *InfraComplex) Sum(xs ...*InfraComplex) *InfraComplex {
+	s := new(InfraComplex)
+	for _, x := range xs {
+		s.Add(s, x)
+	}
+	return z.Set(s)
+}
+
 // Mul sets z equal to the product of x and y, and returns z.
 //
 // The multiplication rules are:
@@ -154,6 +240,36 @@ func (z *InfraComplex) IsZeroDiv() bool {
 	return z.l.Equals(zero)
 }
 
+// IsNilpotent returns true if z raised to the nth power vanishes.
+func (z *InfraComplex) IsNilpotent(n int) bool {
+	zero := new(InfraComplex)
+	if z.Equals(zero) {
+		return true
+	}
+	p := new(InfraComplex).SetOne()
+	for i := 0; i < n; i++ {
+		p.Mul(p, z)
+		if p.Equals(zero) {
+			return true
+		}
+	}
+	return false
+}
+
+// NilpotencyIndex returns the smallest positive n such that z raised to the
+// nth power vanishes, or 0 if there is no such n. The zero divisors are exactly
+// the elements with zero complex part, and they square to zero, so the index
+// is 1 for zero, 2 for any other zero divisor, and 0 otherwise.
+func (z *InfraComplex) NilpotencyIndex() int {
+	switch {
+	case z.IsZero():
+		return 1
+	case z.IsZeroDiv():
+		return 2
+	}
+	return 0
+}
+
 // Quo sets z equal to the quotient of x and y, and returns z.
 func (z *InfraComplex) Quo(x, y *InfraComplex) *InfraComplex {
 	if y.IsZeroDiv() {
@@ -169,6 +285,51 @@ func (z *InfraComplex) Quo(x, y *InfraComplex) *InfraComplex {
 	return z
 }
 
+// Inv sets z equal to the inverse of y, and returns nil. The inverse of y is
+// 		Conj(y) / Quad(y)
+// which is integral only if the complex part of y is a unit (±1 or ±i). If y
+// is a zero divisor, that is, if its complex part is zero, then Inv returns
+// ErrZeroDivisor; if the inverse is not integral, then Inv returns
+// ErrNotIntegral. In both cases z is not modified.
+func (z *InfraComplex) Inv(y *InfraComplex) error {
+	if y.IsZeroDiv() {
+		return ErrZeroDivisor
+	}
+	c := new(InfraComplex).Conj(y)
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = c.Cartesian()
+	if !quoExact(v, y.Quad()) {
+		return ErrNotIntegral
+	}
+	z.Set(c)
+	return nil
+}
+
+// Pow sets z equal to x raised to the nth power, and returns z. Binary
+// exponentiation is used, which is well-defined since multiplication is
+// associative. If n is zero, then z is one. If n is negative, then z is the
+// quotient of one and x raised to the -n power, as given by Quo, so Pow panics
+// if x is a zero divisor.
+func (z *InfraComplex) Pow(x *InfraComplex, n int) *InfraComplex {
+	if n < 0 {
+		p := new(InfraComplex).Pow(x, -n)
+		zero := new(big.Int)
+		return z.Quo(NewInfraComplex(big.NewInt(1), zero, zero, zero), p)
+	}
+	p := new(InfraComplex).Set(x)
+	z.Set(new(InfraComplex))
+	z.l.l.SetInt64(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			z.Mul(z, p)
+		}
+		if n > 1 {
+			p.Mul(p, p)
+		}
+	}
+	return z
+}
+
 // Generate returns a random InfraComplex value for quick.Check testing.
 func (z *InfraComplex) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomInfraComplex := &InfraComplex{
@@ -290,3 +290,221 @@ func TestInfraComplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Parsing
+
+func TestInfraComplexStringSetString(t *testing.T) {
+	f := func(x *InfraComplex) bool {
+		// t.Logf("x = %v", x)
+		l, ok := new(InfraComplex).SetString(x.String())
+		return ok && l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraComplexSetString(t *testing.T) {
+	var tests = []struct {
+		s    string
+		want *InfraComplex
+	}{
+		{"(1+2i+3β+4γ)", NewInfraComplex(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4))},
+		{"(-2i-4γ)", NewInfraComplex(big.NewInt(0), big.NewInt(-2), big.NewInt(0), big.NewInt(-4))},
+		{"(β)", NewInfraComplex(big.NewInt(0), big.NewInt(0), big.NewInt(1), big.NewInt(0))},
+	}
+	for _, test := range tests {
+		if got, ok := new(InfraComplex).SetString(test.s); !ok || !got.Equals(test.want) {
+			t.Errorf("SetString(%q) = %v, %v, want %v", test.s, got, ok, test.want)
+		}
+	}
+	for _, s := range []string{"", "()", "(1+2j)", "(1+2i+3i)", "1+2i"} {
+		if _, ok := new(InfraComplex).SetString(s); ok {
+			t.Errorf("SetString(%q) succeeded, want failure", s)
+		}
+	}
+}
+
+// Powers
+
+func TestInfraComplexPowNaive(t *testing.T) {
+	f := func(x *InfraComplex, n uint8) bool {
+		// t.Logf("x = %v, n = %v", x, n)
+		n = n % 16
+		zero := new(big.Int)
+		r := NewInfraComplex(big.NewInt(1), zero, zero, zero)
+		for i := uint8(0); i < n; i++ {
+			r.Mul(r, x)
+		}
+		return new(InfraComplex).Pow(x, int(n)).Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraComplexPowNegative(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	x := NewInfraComplex(zero, one, big.NewInt(2), big.NewInt(3))
+	l := new(InfraComplex).Pow(x, -3)
+	r := new(InfraComplex).Mul(l, new(InfraComplex).Pow(x, 3))
+	if want := NewInfraComplex(one, zero, zero, zero); !r.Equals(want) {
+		t.Errorf("Mul(Pow(%v, -3), Pow(%v, 3)) = %v, want %v", x, x, r, want)
+	}
+	y := NewInfraComplex(zero, zero, one, one)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Pow(%v, -1) did not panic", y)
+		}
+	}()
+	new(InfraComplex).Pow(y, -1)
+}
+
+// Sums
+
+func TestInfraComplexSum(t *testing.T) {
+	f := func(x, y, w *InfraComplex) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(InfraComplex).Sum(x, y, w)
+		r := new(InfraComplex).Add(new(InfraComplex).Add(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if z := new(InfraComplex).Sum(); !z.Equals(new(InfraComplex)) {
+		t.Errorf("Sum() = %v, want zero", z)
+	}
+}
+
+// Inverses
+
+func TestInfraComplexInv(t *testing.T) {
+	c := func(a, b, d, e int64) *InfraComplex {
+		return NewInfraComplex(big.NewInt(a), big.NewInt(b), big.NewInt(d), big.NewInt(e))
+	}
+	one := c(1, 0, 0, 0)
+	var tests = []struct {
+		y   *InfraComplex
+		err error
+	}{
+		{c(1, 0, 0, 0), nil},
+		{c(0, 1, 2, 3), nil},
+		{c(-1, 0, 4, -5), nil},
+		{c(0, 0, 0, 0), ErrZeroDivisor},
+		{c(0, 0, 1, 2), ErrZeroDivisor},
+		{c(1, 1, 0, 0), ErrNotIntegral},
+		{c(2, 0, 2, 2), ErrNotIntegral},
+	}
+	for _, test := range tests {
+		z := c(7, 7, 7, 7)
+		err := z.Inv(test.y)
+		if err != test.err {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, err, test.err)
+			continue
+		}
+		if err != nil {
+			if !z.Equals(c(7, 7, 7, 7)) {
+				t.Errorf("Inv(%v) modified z to %v", test.y, z)
+			}
+			continue
+		}
+		if p := new(InfraComplex).Mul(test.y, z); !p.Equals(one) {
+			t.Errorf("Mul(%v, Inv(%v)) = %v, want %v", test.y, test.y, p, one)
+		}
+	}
+}
+
+// Real and unreal values
+
+func TestInfraComplexIsReal(t *testing.T) {
+	v := func(a, b, c, d int64) *InfraComplex {
+		return NewInfraComplex(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z            *InfraComplex
+		real, unreal bool
+	}{
+		{v(0, 0, 0, 0), true, false},
+		{v(3, 0, 0, 0), true, false},
+		{v(0, 0, 0, -2), false, true},
+		{v(0, 5, 0, 0), false, true},
+		{v(1, 0, 0, 1), false, false},
+	}
+	for _, test := range tests {
+		if got := test.z.IsReal(); got != test.real {
+			t.Errorf("IsReal(%v) = %v, want %v", test.z, got, test.real)
+		}
+		if got := test.z.IsUnreal(); got != test.unreal {
+			t.Errorf("IsUnreal(%v) = %v, want %v", test.z, got, test.unreal)
+		}
+	}
+}
+
+// Identities
+
+func TestInfraComplexIdentities(t *testing.T) {
+	f := func(x, y *InfraComplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		zero := new(InfraComplex).Set(y).SetZero()
+		one := new(InfraComplex).Set(y).SetOne()
+		if !zero.IsZero() || !zero.Equals(new(InfraComplex)) || one.IsZero() {
+			return false
+		}
+		if x.IsZero() != x.Equals(zero) {
+			return false
+		}
+		l := new(InfraComplex).Mul(x, one)
+		r := new(InfraComplex).Mul(one, x)
+		return new(InfraComplex).Add(x, zero).Equals(x) && l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Nilpotents
+
+func TestInfraComplexNilpotencyIndex(t *testing.T) {
+	s := func(a, b, c, d int64) *InfraComplex {
+		return NewInfraComplex(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		x    *InfraComplex
+		want int
+	}{
+		{s(0, 0, 0, 0), 1},
+		{s(0, 0, 1, 0), 2},
+		{s(0, 0, 0, 1), 2},
+		{s(0, 0, 3, -5), 2},
+		{s(1, 0, 0, 0), 0},
+		{s(0, 1, 0, 0), 0},
+		{s(2, -1, 4, 4), 0},
+	}
+	for _, test := range tests {
+		if got := test.x.NilpotencyIndex(); got != test.want {
+			t.Errorf("NilpotencyIndex(%v) = %d, want %d", test.x, got, test.want)
+		}
+		for n := 0; n < 4; n++ {
+			want := test.want != 0 && (n >= test.want || test.want == 1)
+			if got := test.x.IsNilpotent(n); got != want {
+				t.Errorf("IsNilpotent(%v, %d) = %v, want %v", test.x, n, got, want)
+			}
+		}
+	}
+}
+
+func TestInfraComplexNilpotencyIndexPow(t *testing.T) {
+	f := func(x *InfraComplex) bool {
+		// t.Logf("x = %v", x)
+		n := x.NilpotencyIndex()
+		if n == 0 {
+			return !x.IsNilpotent(8)
+		}
+		return new(InfraComplex).Pow(x, n).IsZero() &&
+			x.IsNilpotent(n) && (n == 1 || !x.IsNilpotent(n-1))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -23,17 +23,31 @@ func (z *InfraPerplex) Cartesian() (*big.Int, *big.Int, *big.Int, *big.Int) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// Components returns the four Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *InfraPerplex) Components() []*big.Int {
+	a, b, c, d := z.Cartesian()
+	return []*big.Int{a, b, c, d}
+}
+
 // String returns the string representation of an InfraPerplex value.
 //
 // If z corresponds to a + bs + cτ + dυ, then the string is"(a+bs+cτ+dυ)",
 // similar to complex128 values.
 func (z *InfraPerplex) String() string {
+	return z.StringWith(symbInfraPerplex)
+}
+
+// StringWith returns the string representation of z with the units written as
+// symb, where symb[0] is the unit of the real part. The layout is the same as
+// that of String.
+func (z *InfraPerplex) StringWith(symb [4]string) string {
 	v := make([]*big.Int, 4)
 	v[0], v[1] = z.l.Cartesian()
 	v[2], v[3] = z.r.Cartesian()
 	a := make([]string, 9)
 	a[0] = "("
-	a[1] = fmt.Sprintf("%v", v[0])
+	a[1] = fmt.Sprintf("%v%s", v[0], symb[0])
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
 		if v[i].Sign() < 0 {
@@ -41,13 +55,39 @@ func (z *InfraPerplex) String() string {
 		} else {
 			a[j] = fmt.Sprintf("+%v", v[i])
 		}
-		a[j+1] = symbInfraPerplex[i]
+		a[j+1] = symb[i]
 		i++
 	}
 	a[8] = ")"
 	return strings.Join(a, "")
 }
 
+// Compact returns a compact string representation of z. It is like String, but
+// zero terms are omitted and unit coefficients are collapsed, so that the
+// string is "(1)" or "(1-υ)" instead of listing every component. The zero
+// value gives "(0)".
+func (z *InfraPerplex) Compact() string {
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return "(" + compactTerms(v, symbInfraPerplex[:]) + ")"
+}
+
+// SetString sets z equal to the value of s, and returns z and a boolean
+// indicating success. The string s must be of the form "(a+bs+cτ+dυ)", where
+// omitted terms are zero. If SetString fails, the value of z is undefined but
+// the returned value is nil.
+func (z *InfraPerplex) SetString(s string) (*InfraPerplex, bool) {
+	v, ok := parseTerms(s, symbInfraPerplex[:])
+	if !ok {
+		return nil, false
+	}
+	z.l.l.Set(v[0])
+	z.l.r.Set(v[1])
+	z.r.l.Set(v[2])
+	z.r.r.Set(v[3])
+	return z, true
+}
+
 // Equals returns true if y and z are equal.
 func (z *InfraPerplex) Equals(y *InfraPerplex) bool {
 	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
@@ -56,6 +96,17 @@ func (z *InfraPerplex) Equals(y *InfraPerplex) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *InfraPerplex) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Perplex))
+}
+
+// IsUnreal returns true if the real part of z is zero and at least one of the
+// other components is not, so that z is a nonzero pure unreal value.
+func (z *InfraPerplex) IsUnreal() bool {
+	return z.l.l.Sign() == 0 && !z.IsReal()
+}
+
 // Set sets z equal to y, and returns z.
 func (z *InfraPerplex) Set(y *InfraPerplex) *InfraPerplex {
 	z.l.Set(&y.l)
@@ -63,6 +114,31 @@ func (z *InfraPerplex) Set(y *InfraPerplex) *InfraPerplex {
 	return z
 }
 
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *InfraPerplex) Clone() *InfraPerplex {
+	return new(InfraPerplex).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *InfraPerplex) IsZero() bool {
+	return z.l.IsZero() && z.r.IsZero()
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *InfraPerplex) SetZero() *InfraPerplex {
+	z.l.SetZero()
+	z.r.SetZero()
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *InfraPerplex) SetOne() *InfraPerplex {
+	z.l.SetOne()
+	z.r.SetZero()
+	return z
+}
+
 // NewInfraPerplex returns a pointer to an InfraPerplex value made from four
 // given pointers to big.Int values.
 func NewInfraPerplex(a, b, c, d *big.Int) *InfraPerplex {
@@ -74,6 +150,23 @@ func NewInfraPerplex(a, b, c, d *big.Int) *InfraPerplex {
 	return z
 }
 
+// FromPerplex sets z equal to the perplex number x, with zero nilpotent part,
+// and returns z. Adding a value with zero perplex part then perturbs x in the
+// τ and υ directions, whose products with each other are all zero.
+func (z *InfraPerplex) FromPerplex(x *Perplex) *InfraPerplex {
+	z.l.Set(x)
+	z.r.SetZero()
+	return z
+}
+
+// ToPerplex returns a pointer to a new Perplex value holding the real and s
+// components of z, and true if the τ and υ components of z are zero. Otherwise
+// the projection is still returned, but with false. In either case it
+// preserves Mul and Quad, since the τ and υ components span an ideal.
+func (z *InfraPerplex) ToPerplex() (*Perplex, bool) {
+	return new(Perplex).Set(&z.l), z.r.IsZero()
+}
+
 // Scal sets z equal to y scaled by a, and returns z.
 func (z *InfraPerplex) Scal(y *InfraPerplex, a *big.Int) *InfraPerplex {
 	z.l.Scal(&y.l, a)
@@ -109,6 +202,16 @@ func (z *InfraPerplex) Sub(x, y *InfraPerplex) *InfraPerplex {
 	return z
 }
 
+// Sum sets z equal to the sum of xs, and returns z. If xs is empty, then z is
+// zero.
+func (z *InfraPerplex) Sum(xs ...*InfraPerplex) *InfraPerplex {
+	s := new(InfraPerplex)
+	for _, x := range xs {
+		s.Add(s, x)
+	}
+	return z.Set(s)
+}
+
 // Mul sets z equal to the product of x and y, and returns z.
 //
 // The multiplication rules are:
@@ -147,6 +250,17 @@ func (z *InfraPerplex) Quad() *big.Int {
 	return z.l.Quad()
 }
 
+// Dot returns the bilinear form of z and y associated with Quad. If
+// z = a+bs+cτ+dυ and y = e+fs+gτ+hυ, then the form is
+// 		Mul(a, e) - Mul(b, f)
+// This is the real part of Mul(z, Conj(y)). It is degenerate, since it only
+// depends on the perplex parts of z and y, and it is indefinite. Note that
+// Dot(z, z) is the quadrance of z.
+func (z *InfraPerplex) Dot(y *InfraPerplex) *big.Int {
+	dot := new(big.Int).Mul(&z.l.l, &y.l.l)
+	return dot.Sub(dot, new(big.Int).Mul(&z.l.r, &y.l.r))
+}
+
 // IsZeroDiv returns true if z is a zero divisor. This is equivalent to z being
 // nilpotent.
 func (z *InfraPerplex) IsZeroDiv() bool {
@@ -168,6 +282,52 @@ func (z *InfraPerplex) Quo(x, y *InfraPerplex) *InfraPerplex {
 	return z
 }
 
+// Inv sets z equal to the inverse of y, and returns nil. The inverse of y is
+// 		Conj(y) / Quad(y)
+// which is integral only if the perplex part of y is a unit (±1 or ±s). The
+// zero divisors are the values whose perplex part is lightlike; for those, Inv
+// returns an error wrapping ErrZeroDivisor. If y is invertible but the inverse
+// is not integral, then Inv returns ErrNotIntegral instead. In both cases z is
+// not modified.
+func (z *InfraPerplex) Inv(y *InfraPerplex) error {
+	if y.IsZeroDiv() {
+		return fmt.Errorf("%w: the perplex part of %v is lightlike", ErrZeroDivisor, y)
+	}
+	c := new(InfraPerplex).Conj(y)
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = c.Cartesian()
+	if !quoExact(v, y.Quad()) {
+		return ErrNotIntegral
+	}
+	z.Set(c)
+	return nil
+}
+
+// Pow sets z equal to x raised to the nth power, and returns z. Binary
+// exponentiation is used, which is well-defined since multiplication is
+// associative. If n is zero, then z is one. If n is negative, then z is the
+// quotient of one and x raised to the -n power, as given by Quo, so Pow panics
+// if x is a zero divisor.
+func (z *InfraPerplex) Pow(x *InfraPerplex, n int) *InfraPerplex {
+	if n < 0 {
+		p := new(InfraPerplex).Pow(x, -n)
+		zero := new(big.Int)
+		return z.Quo(NewInfraPerplex(big.NewInt(1), zero, zero, zero), p)
+	}
+	p := new(InfraPerplex).Set(x)
+	z.Set(new(InfraPerplex))
+	z.l.l.SetInt64(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			z.Mul(z, p)
+		}
+		if n > 1 {
+			p.Mul(p, p)
+		}
+	}
+	return z
+}
+
 // Generate returns a random InfraPerplex value for quick.Check testing.
 func (z *InfraPerplex) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomInfraPerplex := &InfraPerplex{
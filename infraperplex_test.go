@@ -4,6 +4,7 @@
 package integral
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 	"testing/quick"
@@ -290,3 +291,246 @@ func TestInfraPerplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Parsing
+
+func TestInfraPerplexStringSetString(t *testing.T) {
+	f := func(x *InfraPerplex) bool {
+		// t.Logf("x = %v", x)
+		l, ok := new(InfraPerplex).SetString(x.String())
+		return ok && l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraPerplexSetString(t *testing.T) {
+	var tests = []struct {
+		s    string
+		want *InfraPerplex
+	}{
+		{"(1+2s+3τ+4υ)", NewInfraPerplex(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4))},
+		{"(-3τ-υ)", NewInfraPerplex(big.NewInt(0), big.NewInt(0), big.NewInt(-3), big.NewInt(-1))},
+		{"(υ+5)", NewInfraPerplex(big.NewInt(5), big.NewInt(0), big.NewInt(0), big.NewInt(1))},
+	}
+	for _, test := range tests {
+		if got, ok := new(InfraPerplex).SetString(test.s); !ok || !got.Equals(test.want) {
+			t.Errorf("SetString(%q) = %v, %v, want %v", test.s, got, ok, test.want)
+		}
+	}
+	for _, s := range []string{"", "()", "(1+2t)", "(1+2s+3s)", "(1+2s"} {
+		if _, ok := new(InfraPerplex).SetString(s); ok {
+			t.Errorf("SetString(%q) succeeded, want failure", s)
+		}
+	}
+}
+
+// Powers
+
+func TestInfraPerplexPowNaive(t *testing.T) {
+	f := func(x *InfraPerplex, n uint8) bool {
+		// t.Logf("x = %v, n = %v", x, n)
+		n = n % 16
+		zero := new(big.Int)
+		r := NewInfraPerplex(big.NewInt(1), zero, zero, zero)
+		for i := uint8(0); i < n; i++ {
+			r.Mul(r, x)
+		}
+		return new(InfraPerplex).Pow(x, int(n)).Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraPerplexPowNegative(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	x := NewInfraPerplex(zero, one, big.NewInt(2), big.NewInt(3))
+	l := new(InfraPerplex).Pow(x, -3)
+	r := new(InfraPerplex).Mul(l, new(InfraPerplex).Pow(x, 3))
+	if want := NewInfraPerplex(one, zero, zero, zero); !r.Equals(want) {
+		t.Errorf("Mul(Pow(%v, -3), Pow(%v, 3)) = %v, want %v", x, x, r, want)
+	}
+	y := NewInfraPerplex(one, one, zero, zero)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Pow(%v, -1) did not panic", y)
+		}
+	}()
+	new(InfraPerplex).Pow(y, -1)
+}
+
+// Sums
+
+func TestInfraPerplexSum(t *testing.T) {
+	f := func(x, y, w *InfraPerplex) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(InfraPerplex).Sum(x, y, w)
+		r := new(InfraPerplex).Add(new(InfraPerplex).Add(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if z := new(InfraPerplex).Sum(); !z.Equals(new(InfraPerplex)) {
+		t.Errorf("Sum() = %v, want zero", z)
+	}
+}
+
+// Inverses
+
+func TestInfraPerplexInv(t *testing.T) {
+	p := func(a, b, c, d int64) *InfraPerplex {
+		return NewInfraPerplex(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	one := p(1, 0, 0, 0)
+	var tests = []struct {
+		y   *InfraPerplex
+		err error
+	}{
+		{p(1, 0, 0, 0), nil},
+		{p(0, 1, 2, 3), nil},
+		{p(-1, 0, 4, -5), nil},
+		{p(0, 0, 0, 0), ErrZeroDivisor},
+		{p(2, 2, 1, 0), ErrZeroDivisor},
+		{p(3, -3, 0, 1), ErrZeroDivisor},
+		{p(2, 1, 0, 0), ErrNotIntegral},
+		{p(2, 0, 2, 2), ErrNotIntegral},
+	}
+	for _, test := range tests {
+		z := p(7, 7, 7, 7)
+		err := z.Inv(test.y)
+		if !errors.Is(err, test.err) {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, err, test.err)
+			continue
+		}
+		if err != nil {
+			if !z.Equals(p(7, 7, 7, 7)) {
+				t.Errorf("Inv(%v) modified z to %v", test.y, z)
+			}
+			continue
+		}
+		if q := new(InfraPerplex).Mul(test.y, z); !q.Equals(one) {
+			t.Errorf("Mul(%v, Inv(%v)) = %v, want %v", test.y, test.y, q, one)
+		}
+	}
+}
+
+// Real and unreal values
+
+func TestInfraPerplexIsReal(t *testing.T) {
+	v := func(a, b, c, d int64) *InfraPerplex {
+		return NewInfraPerplex(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z            *InfraPerplex
+		real, unreal bool
+	}{
+		{v(0, 0, 0, 0), true, false},
+		{v(3, 0, 0, 0), true, false},
+		{v(0, 0, 0, -2), false, true},
+		{v(0, 5, 0, 0), false, true},
+		{v(1, 0, 0, 1), false, false},
+	}
+	for _, test := range tests {
+		if got := test.z.IsReal(); got != test.real {
+			t.Errorf("IsReal(%v) = %v, want %v", test.z, got, test.real)
+		}
+		if got := test.z.IsUnreal(); got != test.unreal {
+			t.Errorf("IsUnreal(%v) = %v, want %v", test.z, got, test.unreal)
+		}
+	}
+}
+
+// Identities
+
+func TestInfraPerplexIdentities(t *testing.T) {
+	f := func(x, y *InfraPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		zero := new(InfraPerplex).Set(y).SetZero()
+		one := new(InfraPerplex).Set(y).SetOne()
+		if !zero.IsZero() || !zero.Equals(new(InfraPerplex)) || one.IsZero() {
+			return false
+		}
+		if x.IsZero() != x.Equals(zero) {
+			return false
+		}
+		l := new(InfraPerplex).Mul(x, one)
+		r := new(InfraPerplex).Mul(one, x)
+		return new(InfraPerplex).Add(x, zero).Equals(x) && l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Inner products
+
+func TestInfraPerplexDotQuad(t *testing.T) {
+	f := func(x *InfraPerplex) bool {
+		// t.Logf("x = %v", x)
+		return x.Dot(x).Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraPerplexDotSymmetric(t *testing.T) {
+	f := func(x, y *InfraPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p := new(InfraPerplex).Mul(x, new(InfraPerplex).Conj(y))
+		a, _, _, _ := p.Cartesian()
+		return x.Dot(y).Cmp(y.Dot(x)) == 0 && x.Dot(y).Cmp(a) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraPerplexDotPolarization(t *testing.T) {
+	f := func(x, y *InfraPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(InfraPerplex).Add(x, y).Quad()
+		r := new(big.Int).Add(x.Quad(), y.Quad())
+		r.Add(r, new(big.Int).Lsh(x.Dot(y), 1))
+		return l.Cmp(r) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Embeddings
+
+func TestInfraPerplexFromPerplex(t *testing.T) {
+	f := func(x *Perplex, y *InfraPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if z, ok := new(InfraPerplex).FromPerplex(x).ToPerplex(); !ok || !z.Equals(x) {
+			return false
+		}
+		p, _ := y.ToPerplex()
+		n := new(InfraPerplex).Sub(y, new(InfraPerplex).FromPerplex(p))
+		return new(InfraPerplex).Mul(n, n).IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInfraPerplexToPerplex(t *testing.T) {
+	f := func(x, y *InfraPerplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, ok := x.ToPerplex()
+		if ok != x.r.IsZero() || a.Quad().Cmp(x.Quad()) != 0 {
+			return false
+		}
+		b, _ := y.ToPerplex()
+		prod, _ := new(InfraPerplex).Mul(x, y).ToPerplex()
+		return prod.Equals(new(Perplex).Mul(a, b))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
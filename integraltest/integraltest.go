@@ -0,0 +1,113 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+// Package integraltest implements utilities for testing the number types of
+// package integral, and of other types that satisfy integral.Number.
+package integraltest
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+
+	"github.com/meirizarrygelpi/integral"
+)
+
+// CheckRing runs quick.Check tests of the algebraic laws shared by every
+// integral.Number type, reporting failures to t. Random values of type P are
+// made by its Generate method, so P should implement quick.Generator. The laws
+// are those of a nonassociative ring with a scaling by integers and a
+// conjugation:
+// 		Add is commutative and associative, with identity zero and inverse Neg
+// 		Mul has identity SetOne, and distributes over Add on both sides
+// 		Scal distributes over Add, and commutes with Mul
+// 		Conj is an involution that preserves Add and reverses Mul
+// 		Quad(Conj(x)) = Quad(x)
+// Since Mul is neither commutative nor associative for some types, CheckRing
+// does not test either property.
+func CheckRing[T any, P integral.Number[T]](t *testing.T) {
+	t.Helper()
+	check := func(name string, f interface{}) {
+		t.Helper()
+		if err := quick.Check(f, nil); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+	check("AddCommutative", func(x, y P) bool {
+		return P(P(new(T)).Add(x, y)).Equals(P(new(T)).Add(y, x))
+	})
+	check("AddAssociative", func(x, y, z P) bool {
+		l, r := P(new(T)), P(new(T))
+		l.Add(l.Add(x, y), z)
+		r.Add(x, r.Add(y, z))
+		return l.Equals(r)
+	})
+	check("AddZero", func(x P) bool {
+		return P(P(new(T)).Add(x, new(T))).Equals(x)
+	})
+	check("AddNeg", func(x P) bool {
+		l := P(new(T))
+		l.Add(l.Neg(x), x)
+		r := P(new(T))
+		r.Sub(x, x)
+		return l.IsZero() && r.IsZero()
+	})
+	check("MulOne", func(x P) bool {
+		one, l, r := P(new(T)), P(new(T)), P(new(T))
+		one.SetOne()
+		l.Mul(one, x)
+		r.Mul(x, one)
+		return l.Equals(x) && r.Equals(x)
+	})
+	check("MulLeftDistributive", func(x, y, z P) bool {
+		l, r := P(new(T)), P(new(T))
+		l.Mul(x, l.Add(y, z))
+		r.Add(r.Mul(x, y), P(new(T)).Mul(x, z))
+		return l.Equals(r)
+	})
+	check("MulRightDistributive", func(x, y, z P) bool {
+		l, r := P(new(T)), P(new(T))
+		l.Mul(l.Add(x, y), z)
+		r.Add(r.Mul(x, z), P(new(T)).Mul(y, z))
+		return l.Equals(r)
+	})
+	check("ScalDistributive", func(x, y P, a, b int64) bool {
+		ba, bb := big.NewInt(a), big.NewInt(b)
+		l, r := P(new(T)), P(new(T))
+		l.Scal(l.Add(x, y), ba)
+		r.Add(r.Scal(x, ba), P(new(T)).Scal(y, ba))
+		if !l.Equals(r) {
+			return false
+		}
+		l.Scal(x, new(big.Int).Add(ba, bb))
+		r.Add(r.Scal(x, ba), P(new(T)).Scal(x, bb))
+		return l.Equals(r)
+	})
+	check("ScalMul", func(x, y P, a int64) bool {
+		ba := big.NewInt(a)
+		l, r := P(new(T)), P(new(T))
+		l.Scal(l.Mul(x, y), ba)
+		r.Mul(r.Scal(x, ba), y)
+		return l.Equals(r)
+	})
+	check("ConjInvolutive", func(x P) bool {
+		l := P(new(T))
+		l.Conj(l.Conj(x))
+		return l.Equals(x)
+	})
+	check("ConjAdd", func(x, y P) bool {
+		l, r := P(new(T)), P(new(T))
+		l.Conj(l.Add(x, y))
+		r.Add(r.Conj(x), P(new(T)).Conj(y))
+		return l.Equals(r)
+	})
+	check("ConjMul", func(x, y P) bool {
+		l, r := P(new(T)), P(new(T))
+		l.Conj(l.Mul(x, y))
+		r.Mul(r.Conj(y), P(new(T)).Conj(x))
+		return l.Equals(r)
+	})
+	check("QuadConj", func(x P) bool {
+		return P(P(new(T)).Conj(x)).Quad().Cmp(x.Quad()) == 0
+	})
+}
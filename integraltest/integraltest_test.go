@@ -0,0 +1,25 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integraltest
+
+import (
+	"testing"
+
+	"github.com/meirizarrygelpi/integral"
+)
+
+func TestCheckRing(t *testing.T) {
+	t.Run("Complex", CheckRing[integral.Complex])
+	t.Run("Perplex", CheckRing[integral.Perplex])
+	t.Run("Infra", CheckRing[integral.Infra])
+	t.Run("Hamilton", CheckRing[integral.Hamilton])
+	t.Run("Cockle", CheckRing[integral.Cockle])
+	t.Run("Supra", CheckRing[integral.Supra])
+	t.Run("InfraComplex", CheckRing[integral.InfraComplex])
+	t.Run("InfraPerplex", CheckRing[integral.InfraPerplex])
+	t.Run("BiComplex", CheckRing[integral.BiComplex])
+	t.Run("Cayley", CheckRing[integral.Cayley])
+	t.Run("Sedenion", CheckRing[integral.Sedenion])
+	t.Run("Zorn", CheckRing[integral.Zorn])
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	// ErrZeroDivisor is returned by Inv when the value to be inverted is a zero
+	// divisor, so that it has no inverse at all.
+	ErrZeroDivisor = errors.New("zero divisor has no inverse")
+
+	// ErrNotIntegral is returned by Inv when the value to be inverted has an
+	// inverse, but that inverse has non-integral components.
+	ErrNotIntegral = errors.New("inverse is not integral")
+)
+
+// quoExact divides each component of v by d in place, and returns true, if d
+// divides every component exactly. Otherwise v is not modified and quoExact
+// returns false.
+func quoExact(v []*big.Int, d *big.Int) bool {
+	r := new(big.Int)
+	for _, e := range v {
+		if r.Rem(e, d); r.Sign() != 0 {
+			return false
+		}
+	}
+	for _, e := range v {
+		e.Quo(e, d)
+	}
+	return true
+}
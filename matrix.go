@@ -0,0 +1,57 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import "math/big"
+
+// rank returns the rank of the integral matrix with the given rows. It uses
+// fraction-free Gaussian elimination, so the rank is the same over the integers
+// and over the rationals. Each elimination step is divided exactly by the
+// previous pivot, as in the Bareiss algorithm, so the entries stay bounded by
+// the minors of the matrix. The rows are not modified.
+func rank(rows [][]*big.Int) int {
+	m := make([][]*big.Int, len(rows))
+	for i, row := range rows {
+		m[i] = make([]*big.Int, len(row))
+		for j, e := range row {
+			m[i][j] = new(big.Int).Set(e)
+		}
+	}
+	r := 0
+	temp := new(big.Int)
+	prev := big.NewInt(1)
+	for c := 0; r < len(m) && c < len(m[r]); c++ {
+		p := r
+		for p < len(m) && m[p][c].Sign() == 0 {
+			p++
+		}
+		if p == len(m) {
+			continue
+		}
+		m[r], m[p] = m[p], m[r]
+		for i := r + 1; i < len(m); i++ {
+			f := new(big.Int).Set(m[i][c])
+			for j := c; j < len(m[i]); j++ {
+				m[i][j].Sub(
+					m[i][j].Mul(m[i][j], m[r][c]),
+					temp.Mul(f, m[r][j]),
+				)
+				m[i][j].Quo(m[i][j], prev)
+			}
+		}
+		prev = m[r][c]
+		r++
+	}
+	return r
+}
+
+// dotRat returns the Euclidean inner product of the rational vectors x and y.
+func dotRat(x, y []*big.Rat) *big.Rat {
+	dot := new(big.Rat)
+	temp := new(big.Rat)
+	for i := range x {
+		dot.Add(dot, temp.Mul(x[i], y[i]))
+	}
+	return dot
+}
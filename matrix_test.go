@@ -0,0 +1,64 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"math/big"
+	"testing"
+)
+
+// Rank
+
+func TestRank(t *testing.T) {
+	m := func(rows ...[]int64) [][]*big.Int {
+		a := make([][]*big.Int, len(rows))
+		for i, row := range rows {
+			a[i] = make([]*big.Int, len(row))
+			for j, e := range row {
+				a[i][j] = big.NewInt(e)
+			}
+		}
+		return a
+	}
+	var tests = []struct {
+		name string
+		rows [][]*big.Int
+		want int
+	}{
+		{"empty", m(), 0},
+		{"zero", m([]int64{0, 0, 0}, []int64{0, 0, 0}), 0},
+		{"identity", m([]int64{1, 0, 0}, []int64{0, 1, 0}, []int64{0, 0, 1}), 3},
+		{"dependent rows", m([]int64{1, 2, 3}, []int64{2, 4, 6}, []int64{1, 0, 1}), 2},
+		{"zero row", m([]int64{1, 1}, []int64{0, 0}, []int64{1, 2}), 2},
+		{"zero first column", m([]int64{0, 1, 2}, []int64{0, 2, 4}, []int64{0, 0, 1}), 2},
+		{"skipped middle column", m([]int64{1, 2, 3}, []int64{2, 4, 7}), 2},
+		{"skipped column then pivot", m(
+			[]int64{0, 2, 1, 0},
+			[]int64{0, 4, 2, 1},
+			[]int64{0, 6, 3, 1},
+			[]int64{1, 0, 0, 0},
+		), 3},
+		{"exact division", m([]int64{2, 3, 5}, []int64{7, 11, 13}, []int64{17, 19, 23}), 3},
+		{"singular", m([]int64{2, 3, 5}, []int64{7, 11, 13}, []int64{9, 14, 18}), 2},
+		{"tall", m([]int64{1, 2}, []int64{3, 4}, []int64{5, 6}, []int64{7, 8}), 2},
+	}
+	for _, test := range tests {
+		before := make([][]string, len(test.rows))
+		for i, row := range test.rows {
+			for _, e := range row {
+				before[i] = append(before[i], e.String())
+			}
+		}
+		if got := rank(test.rows); got != test.want {
+			t.Errorf("%s: rank = %v, want %v", test.name, got, test.want)
+		}
+		for i, row := range test.rows {
+			for j, e := range row {
+				if e.String() != before[i][j] {
+					t.Errorf("%s: rank modified entry (%d, %d)", test.name, i, j)
+				}
+			}
+		}
+	}
+}
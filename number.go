@@ -0,0 +1,30 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import "math/big"
+
+// A Number is one of the integral number types of this package, with the
+// methods shared by all of them. The type parameter T is the underlying struct
+// type, so that a Number is a pointer type like *Complex or *Hamilton. This
+// allows generic algorithms over any of these types, for example
+// 		func Square[T any, P Number[T]](x P) P {
+// 			return P(new(T)).Mul(x, x)
+// 		}
+// Every type of this package is a Number, except CayleyDickson, which has no
+// quadrance.
+type Number[T any] interface {
+	*T
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Neg(y *T) *T
+	Conj(y *T) *T
+	Scal(y *T, a *big.Int) *T
+	Mul(x, y *T) *T
+	Quad() *big.Int
+	SetOne() *T
+	IsZero() bool
+	Equals(y *T) bool
+	String() string
+}
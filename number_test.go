@@ -0,0 +1,143 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// horner returns the value at x of the polynomial with integral coefficients
+// cs, listed from the highest degree down, using Horner's method. It is an
+// example of a generic algorithm over Number types.
+func horner[T any, P Number[T]](x P, cs ...int64) P {
+	p := P(new(T))
+	one := P(P(new(T)).SetOne())
+	for _, c := range cs {
+		p.Mul(p, x)
+		p.Add(p, P(new(T)).Scal(one, big.NewInt(c)))
+	}
+	return p
+}
+
+// powerSum returns the same value as horner, computed term by term from powers
+// of x.
+func powerSum[T any, P Number[T]](x P, cs ...int64) P {
+	s := P(new(T))
+	pow := P(P(new(T)).SetOne())
+	for i := len(cs) - 1; i >= 0; i-- {
+		s.Add(s, P(new(T)).Scal(pow, big.NewInt(cs[i])))
+		pow.Mul(pow, x)
+	}
+	return s
+}
+
+// checkHorner reports whether horner and powerSum agree for random values of
+// type T.
+func checkHorner[T any, P Number[T]](t *testing.T) {
+	f := func(x P) bool {
+		// t.Logf("x = %v", x)
+		return horner[T, P](x, 2, -3, 0, 5).Equals(powerSum[T, P](x, 2, -3, 0, 5))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNumberHorner(t *testing.T) {
+	checkHorner[Complex](t)
+	checkHorner[Perplex](t)
+	checkHorner[Infra](t)
+	checkHorner[Hamilton](t)
+	checkHorner[Cockle](t)
+	checkHorner[Supra](t)
+	checkHorner[InfraComplex](t)
+	checkHorner[InfraPerplex](t)
+	checkHorner[BiComplex](t)
+	checkHorner[Cayley](t)
+	checkHorner[Sedenion](t)
+	checkHorner[Zorn](t)
+}
+
+// isRoot reports whether x is a root of the polynomial with integral
+// coefficients cs, listed from the highest degree down.
+func isRoot[T any, P Number[T]](x P, cs ...int64) bool {
+	return horner[T, P](x, cs...).IsZero()
+}
+
+func TestNumberHornerValue(t *testing.T) {
+	zero, one := new(big.Int), big.NewInt(1)
+	// Each unit is a root of its minimal polynomial, and 1+i is a root of
+	// x^2 - 2x + 2.
+	if x := NewComplex(one, one); !isRoot[Complex](x, 1, -2, 2) {
+		t.Errorf("%v is not a root of x^2 - 2x + 2", x)
+	}
+	if x := NewHamilton(zero, zero, one, zero); !isRoot[Hamilton](x, 1, 0, 1) {
+		t.Errorf("%v is not a root of x^2 + 1", x)
+	}
+	if x := NewPerplex(zero, one); !isRoot[Perplex](x, 1, 0, -1) {
+		t.Errorf("%v is not a root of x^2 - 1", x)
+	}
+	if x := NewInfra(zero, one); !isRoot[Infra](x, 1, 0, 0) || isRoot[Infra](x, 1, 0) {
+		t.Errorf("%v is not a root of x^2 only", x)
+	}
+}
+
+// Clones
+
+// checkClone reports whether Clone returns an equal value that does not share
+// storage with the original, for random values of type T.
+func checkClone[T any, P interface {
+	*T
+	Add(x, y *T) *T
+	Set(y *T) *T
+	Equals(y *T) bool
+	Clone() *T
+}](t *testing.T) {
+	f := func(x P) bool {
+		// t.Logf("x = %v", x)
+		want := P(new(T))
+		want.Set(x)
+		c := P(x.Clone())
+		if !c.Equals(x) {
+			return false
+		}
+		c.Add(c, c)
+		return x.Equals(want) && !c.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestClone(t *testing.T) {
+	t.Run("Complex", checkClone[Complex])
+	t.Run("Perplex", checkClone[Perplex])
+	t.Run("Infra", checkClone[Infra])
+	t.Run("Hamilton", checkClone[Hamilton])
+	t.Run("Cockle", checkClone[Cockle])
+	t.Run("Supra", checkClone[Supra])
+	t.Run("InfraComplex", checkClone[InfraComplex])
+	t.Run("InfraPerplex", checkClone[InfraPerplex])
+	t.Run("Cayley", checkClone[Cayley])
+	t.Run("Sedenion", checkClone[Sedenion])
+	t.Run("BiComplex", checkClone[BiComplex])
+	t.Run("Zorn", checkClone[Zorn])
+}
+
+func TestCayleyDicksonClone(t *testing.T) {
+	l := NewComplex(big.NewInt(1), big.NewInt(-2))
+	r := NewComplex(big.NewInt(3), big.NewInt(4))
+	x := NewCayleyDickson[Complex, *Complex, ComplexDoubling](l, r)
+	c := x.Clone()
+	if !c.Equals(x) {
+		t.Fatalf("Clone(%v) = %v", x, c)
+	}
+	a, _ := c.Halves()
+	a.Add(a, a)
+	if !x.Equals(NewCayleyDickson[Complex, *Complex, ComplexDoubling](l, r)) {
+		t.Errorf("modifying the clone changed the original to %v", x)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"math/big"
+	"strings"
+)
+
+// parseTerms parses a string of the form "(a+bx+cy+...)", where the units are
+// given by symb and symb[0] is the empty symbol of the real unit. It returns
+// the integral components in the order of symb, and true on success.
+//
+// Terms may appear in any order, but each unit may appear at most once. An
+// omitted term is zero, and a unit with no coefficient, like "+x" or "-x", has
+// coefficient +1 or -1.
+func parseTerms(s string, symb []string) ([]*big.Int, bool) {
+	if len(s) < 3 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, false
+	}
+	s = s[1 : len(s)-1]
+	v := make([]*big.Int, len(symb))
+	for s != "" {
+		var term string
+		if n := strings.IndexAny(s[1:], "+-"); n < 0 {
+			term, s = s, ""
+		} else {
+			term, s = s[:n+1], s[n+1:]
+		}
+		i, c, ok := parseTerm(term, symb)
+		if !ok || v[i] != nil {
+			return nil, false
+		}
+		v[i] = c
+	}
+	for i := range v {
+		if v[i] == nil {
+			v[i] = new(big.Int)
+		}
+	}
+	return v, true
+}
+
+// parseTerm parses a single signed term like "-12x". It returns the index of
+// the unit in symb, the coefficient, and true on success.
+func parseTerm(term string, symb []string) (int, *big.Int, bool) {
+	neg := false
+	switch {
+	case strings.HasPrefix(term, "+"):
+		term = term[1:]
+	case strings.HasPrefix(term, "-"):
+		neg, term = true, term[1:]
+	}
+	i := 0
+	for j := 1; j < len(symb); j++ {
+		if strings.HasSuffix(term, symb[j]) {
+			i, term = j, strings.TrimSuffix(term, symb[j])
+			break
+		}
+	}
+	c := new(big.Int)
+	switch {
+	case term == "" && i == 0:
+		return 0, nil, false
+	case term == "":
+		c.SetInt64(1)
+	default:
+		for _, r := range term {
+			if r < '0' || r > '9' {
+				return 0, nil, false
+			}
+		}
+		c.SetString(term, 10)
+	}
+	if neg {
+		c.Neg(c)
+	}
+	return i, c, true
+}
@@ -11,6 +11,8 @@ import (
 	"strings"
 )
 
+var symbPerplex = [2]string{"", "s"}
+
 // A Perplex represents an integral perplex number.
 type Perplex struct {
 	l, r big.Int
@@ -21,24 +23,46 @@ func (z *Perplex) Cartesian() (*big.Int, *big.Int) {
 	return &z.l, &z.r
 }
 
+// Components returns the two Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *Perplex) Components() []*big.Int {
+	a, b := z.Cartesian()
+	return []*big.Int{a, b}
+}
+
 // String returns the string version of a Perplex value.
 //
 // If z corresponds to a + bs, then the string is "(a+bs)", similar to
 // complex128 values.
 func (z *Perplex) String() string {
+	return z.StringWith(symbPerplex)
+}
+
+// StringWith returns the string representation of z with the units written as
+// symb, where symb[0] is the unit of the real part. The layout is the same as
+// that of String.
+func (z *Perplex) StringWith(symb [2]string) string {
 	a := make([]string, 5)
 	a[0] = "("
-	a[1] = fmt.Sprintf("%v", &z.l)
+	a[1] = fmt.Sprintf("%v%s", &z.l, symb[0])
 	if z.r.Sign() == -1 {
 		a[2] = fmt.Sprintf("%v", &z.r)
 	} else {
 		a[2] = fmt.Sprintf("+%v", &z.r)
 	}
-	a[3] = "s"
+	a[3] = symb[1]
 	a[4] = ")"
 	return strings.Join(a, "")
 }
 
+// Compact returns a compact string representation of z. It is like String, but
+// zero terms are omitted and unit coefficients are collapsed, so that the
+// string is "(1)" or "(1-s)" instead of listing every component. The zero
+// value gives "(0)".
+func (z *Perplex) Compact() string {
+	return "(" + compactTerms([]*big.Int{&z.l, &z.r}, symbPerplex[:]) + ")"
+}
+
 // Equals returns true if y and z are equal.
 func (z *Perplex) Equals(y *Perplex) bool {
 	if z.l.Cmp(&y.l) != 0 || z.r.Cmp(&y.r) != 0 {
@@ -47,6 +71,17 @@ func (z *Perplex) Equals(y *Perplex) bool {
 	return true
 }
 
+// IsReal returns true if the non-real component of z is zero.
+func (z *Perplex) IsReal() bool {
+	return z.r.Sign() == 0
+}
+
+// IsUnreal returns true if the real part of z is zero and the other component
+// is not, so that z is a nonzero pure unreal value.
+func (z *Perplex) IsUnreal() bool {
+	return z.l.Sign() == 0 && z.r.Sign() != 0
+}
+
 // Set copies y onto z, and returns z.
 func (z *Perplex) Set(y *Perplex) *Perplex {
 	z.l.Set(&y.l)
@@ -54,6 +89,31 @@ func (z *Perplex) Set(y *Perplex) *Perplex {
 	return z
 }
 
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *Perplex) Clone() *Perplex {
+	return new(Perplex).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *Perplex) IsZero() bool {
+	return z.l.Sign() == 0 && z.r.Sign() == 0
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *Perplex) SetZero() *Perplex {
+	z.l.SetInt64(0)
+	z.r.SetInt64(0)
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *Perplex) SetOne() *Perplex {
+	z.l.SetInt64(1)
+	z.r.SetInt64(0)
+	return z
+}
+
 // NewPerplex returns a pointer to the Perplex value a+bs.
 func NewPerplex(a, b *big.Int) *Perplex {
 	z := new(Perplex)
@@ -97,6 +157,16 @@ func (z *Perplex) Sub(x, y *Perplex) *Perplex {
 	return z
 }
 
+// Sum sets z equal to the sum of xs, and returns z. If xs is empty, then z is
+// zero.
+func (z *Perplex) Sum(xs ...*Perplex) *Perplex {
+	s := new(Perplex)
+	for _, x := range xs {
+		s.Add(s, x)
+	}
+	return z.Set(s)
+}
+
 // Mul sets z equal to the product of x and y, and returns z.
 //
 // The multiplication rule is:
@@ -141,6 +211,81 @@ func (z *Perplex) IsZeroDiv() bool {
 	return false
 }
 
+// Diagonal returns the coordinates of z in the basis of the idempotents
+// 		e+ = (1+s)/2 and e- = (1-s)/2
+// If z = a+bs, then z = (a+b)e+ + (a-b)e-, so the coordinates are a+b and a-b.
+// In these coordinates multiplication is componentwise, so the coordinates of
+// Mul(x, y) are the products of the coordinates of x and y. Note that e+ and
+// e- are not integral, so the two coordinates always have the same parity.
+func (z *Perplex) Diagonal() (*big.Int, *big.Int) {
+	return new(big.Int).Add(&z.l, &z.r), new(big.Int).Sub(&z.l, &z.r)
+}
+
+// FromDiagonal sets z equal to p e+ + q e-, the inverse of Diagonal, and
+// returns z and true. Since z = (p+q)/2 + ((p-q)/2)s, this is integral only if
+// p and q have the same parity. Otherwise z is not modified, and FromDiagonal
+// returns nil and false.
+func (z *Perplex) FromDiagonal(p, q *big.Int) (*Perplex, bool) {
+	a := new(big.Int).Add(p, q)
+	if a.Bit(0) != 0 {
+		return nil, false
+	}
+	z.r.Rsh(z.r.Sub(p, q), 1)
+	z.l.Rsh(a, 1)
+	return z, true
+}
+
+// LightCone returns the null coordinates u = a+b and v = a-b of z = a+bs, so
+// that Quad(z) = Mul(u, v). These are the same as the coordinates given by
+// Diagonal.
+func (z *Perplex) LightCone() (*big.Int, *big.Int) {
+	return z.Diagonal()
+}
+
+// FromLightCone sets z equal to the value with null coordinates u and v, the
+// inverse of LightCone, and returns z and true. Like FromDiagonal, this is
+// integral only if u and v have the same parity. Otherwise z is not modified,
+// and FromLightCone returns nil and false.
+func (z *Perplex) FromLightCone(u, v *big.Int) (*Perplex, bool) {
+	return z.FromDiagonal(u, v)
+}
+
+// Idempotents returns twice the projections of z onto the idempotents e+ and
+// e-, that is, Mul(2z, e+) and Mul(2z, e-). If z = a+bs, then these are
+// 		(a+b)(1+s) and (a-b)(1-s)
+// The projections themselves are integral only if a+b is even, so the doubled
+// ones are returned instead. Their sum is 2z, their product is zero, and each
+// one is a zero divisor.
+func (z *Perplex) Idempotents() (*Perplex, *Perplex) {
+	p, q := z.Diagonal()
+	return NewPerplex(p, p), NewPerplex(q, new(big.Int).Neg(q))
+}
+
+// Signature returns the sign of Quad(z): -1, 0, or +1. Viewing z = a+bs as an
+// event in 1+1 dimensional spacetime, with time a and space b, this tells if z
+// is spacelike, lightlike, or timelike.
+func (z *Perplex) Signature() int {
+	return z.Quad().Sign()
+}
+
+// IsTimelike returns true if Quad(z) is positive, that is, if z lies inside the
+// light cone.
+func (z *Perplex) IsTimelike() bool {
+	return z.Signature() > 0
+}
+
+// IsSpacelike returns true if Quad(z) is negative, that is, if z lies outside
+// the light cone.
+func (z *Perplex) IsSpacelike() bool {
+	return z.Signature() < 0
+}
+
+// IsLightlike returns true if Quad(z) is zero, that is, if z lies on the light
+// cone. This is equivalent to z being a zero divisor.
+func (z *Perplex) IsLightlike() bool {
+	return z.Signature() == 0
+}
+
 // Quo sets z equal to the quotient of x and y, and returns z. Note that
 // truncated division is used.
 func (z *Perplex) Quo(x, y *Perplex) *Perplex {
@@ -155,6 +300,66 @@ func (z *Perplex) Quo(x, y *Perplex) *Perplex {
 	return z
 }
 
+// ExactQuo sets z equal to the quotient of x and y, as given by Quo, and
+// returns z and true, if Quad(y) divides both components of
+// 		Mul(x, Conj(y))
+// so that Mul(y, z) = x. Otherwise z is not modified, and ExactQuo returns nil
+// and false. Unlike Quo, ExactQuo does not panic if y is a zero divisor: a
+// quotient is then not unique even when one exists, so ExactQuo returns nil
+// and false.
+func (z *Perplex) ExactQuo(x, y *Perplex) (*Perplex, bool) {
+	if y.IsZeroDiv() {
+		return nil, false
+	}
+	p := new(Perplex).Conj(y)
+	p.Mul(x, p)
+	if !quoExact([]*big.Int{&p.l, &p.r}, y.Quad()) {
+		return nil, false
+	}
+	return z.Set(p), true
+}
+
+// Inv sets z equal to the inverse of y, and returns nil. The inverse of y is
+// 		Conj(y) / Quad(y)
+// which is integral only if y is a unit (±1 or ±s). The zero divisors are the
+// lightlike values, with a = ±b; for those, Inv returns an error wrapping
+// ErrZeroDivisor. If the inverse is not integral, then Inv returns
+// ErrNotIntegral. In both cases z is not modified.
+func (z *Perplex) Inv(y *Perplex) error {
+	if y.IsZeroDiv() {
+		return fmt.Errorf("%w: %v is lightlike", ErrZeroDivisor, y)
+	}
+	c := new(Perplex).Conj(y)
+	if !quoExact([]*big.Int{&c.l, &c.r}, y.Quad()) {
+		return ErrNotIntegral
+	}
+	z.Set(c)
+	return nil
+}
+
+// Pow sets z equal to x raised to the nth power, and returns z. Binary
+// exponentiation is used. If n is zero, then z is one. If n is negative, then z
+// is the quotient of one and x raised to the -n power, as given by Quo, so Pow
+// panics if x is a zero divisor.
+func (z *Perplex) Pow(x *Perplex, n int) *Perplex {
+	if n < 0 {
+		p := new(Perplex).Pow(x, -n)
+		return z.Quo(NewPerplex(big.NewInt(1), new(big.Int)), p)
+	}
+	p := new(Perplex).Set(x)
+	z.l.SetInt64(1)
+	z.r.SetInt64(0)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			z.Mul(z, p)
+		}
+		if n > 1 {
+			p.Mul(p, p)
+		}
+	}
+	return z
+}
+
 // Generate returns a random Perplex value for quick.Check testing.
 func (z *Perplex) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomPerplex := &Perplex{
@@ -4,7 +4,9 @@
 package integral
 
 import (
+	"errors"
 	"math/big"
+	"strings"
 	"testing"
 	"testing/quick"
 )
@@ -287,3 +289,282 @@ func TestPerplexComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Powers
+
+func TestPerplexPowNaive(t *testing.T) {
+	f := func(x *Perplex, n uint8) bool {
+		// t.Logf("x = %v, n = %v", x, n)
+		n = n % 16
+		r := NewPerplex(big.NewInt(1), new(big.Int))
+		for i := uint8(0); i < n; i++ {
+			r.Mul(r, x)
+		}
+		return new(Perplex).Pow(x, int(n)).Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexPowNegative(t *testing.T) {
+	s := NewPerplex(new(big.Int), big.NewInt(1))
+	if got := new(Perplex).Pow(s, -3); !got.Equals(s) {
+		t.Errorf("Pow(%v, -3) = %v, want %v", s, got, s)
+	}
+	x := NewPerplex(big.NewInt(2), big.NewInt(2))
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Pow(%v, -1) did not panic", x)
+		}
+	}()
+	new(Perplex).Pow(x, -1)
+}
+
+// Sums
+
+func TestPerplexSum(t *testing.T) {
+	f := func(x, y, w *Perplex) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(Perplex).Sum(x, y, w)
+		r := new(Perplex).Add(new(Perplex).Add(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if z := new(Perplex).Sum(); !z.Equals(new(Perplex)) {
+		t.Errorf("Sum() = %v, want zero", z)
+	}
+}
+
+// Inverses
+
+func TestPerplexInv(t *testing.T) {
+	p := func(a, b int64) *Perplex {
+		return NewPerplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		y, want *Perplex
+		err     error
+	}{
+		{p(1, 0), p(1, 0), nil},
+		{p(-1, 0), p(-1, 0), nil},
+		{p(0, 1), p(0, 1), nil},
+		{p(0, -1), p(0, -1), nil},
+		{p(0, 0), nil, ErrZeroDivisor},
+		{p(3, 3), nil, ErrZeroDivisor},
+		{p(2, -2), nil, ErrZeroDivisor},
+		{p(2, 0), nil, ErrNotIntegral},
+		{p(2, 1), nil, ErrNotIntegral},
+	}
+	for _, test := range tests {
+		z := p(7, 7)
+		err := z.Inv(test.y)
+		if !errors.Is(err, test.err) {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, err, test.err)
+			continue
+		}
+		if err != nil {
+			if !z.Equals(p(7, 7)) {
+				t.Errorf("Inv(%v) modified z to %v", test.y, z)
+			}
+			continue
+		}
+		if !z.Equals(test.want) {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, z, test.want)
+		}
+	}
+	if err := new(Perplex).Inv(p(3, 3)); !strings.Contains(err.Error(), "lightlike") {
+		t.Errorf("Inv(%v) = %q, want mention of lightlike", p(3, 3), err)
+	}
+}
+
+// Exact division
+
+func TestPerplexExactQuo(t *testing.T) {
+	f := func(x, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		if y.IsZeroDiv() {
+			return true
+		}
+		q, ok := new(Perplex).ExactQuo(new(Perplex).Mul(x, y), y)
+		return ok && q.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	p := func(a, b int64) *Perplex {
+		return NewPerplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		x, y *Perplex
+	}{
+		{p(1, 0), p(2, 0)},
+		{p(5, 2), p(2, 1)},
+		{p(2, 2), p(1, 1)},
+		{p(1, 0), p(0, 0)},
+	}
+	for _, test := range tests {
+		z := p(7, 7)
+		if q, ok := z.ExactQuo(test.x, test.y); ok || q != nil || !z.Equals(p(7, 7)) {
+			t.Errorf("ExactQuo(%v, %v) = %v, %v, want nil, false", test.x, test.y, q, ok)
+		}
+	}
+}
+
+// Signature
+
+func TestPerplexSignature(t *testing.T) {
+	p := func(a, b int64) *Perplex {
+		return NewPerplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		z    *Perplex
+		want int
+	}{
+		{p(0, 0), 0},
+		{p(3, 3), 0},
+		{p(-2, 2), 0},
+		{p(2, 1), 1},
+		{p(-5, 4), 1},
+		{p(1, 2), -1},
+		{p(0, -1), -1},
+	}
+	for _, test := range tests {
+		z := test.z
+		if got := z.Signature(); got != test.want {
+			t.Errorf("Signature(%v) = %v, want %v", z, got, test.want)
+		}
+		if z.IsTimelike() != (test.want > 0) || z.IsSpacelike() != (test.want < 0) || z.IsLightlike() != (test.want == 0) {
+			t.Errorf("%v is misclassified: timelike %v, spacelike %v, lightlike %v",
+				z, z.IsTimelike(), z.IsSpacelike(), z.IsLightlike())
+		}
+	}
+}
+
+func TestPerplexLightlikeZeroDiv(t *testing.T) {
+	f := func(a, b int8) bool {
+		// t.Logf("a = %v, b = %v", a, b)
+		z := NewPerplex(big.NewInt(int64(a)), big.NewInt(int64(b)))
+		return z.IsLightlike() == z.IsZeroDiv()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Idempotent coordinates
+
+func TestPerplexDiagonalMul(t *testing.T) {
+	f := func(x, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		p1, q1 := x.Diagonal()
+		p2, q2 := y.Diagonal()
+		p, q := new(Perplex).Mul(x, y).Diagonal()
+		return p.Cmp(p1.Mul(p1, p2)) == 0 && q.Cmp(q1.Mul(q1, q2)) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexFromDiagonal(t *testing.T) {
+	f := func(x *Perplex) bool {
+		// t.Logf("x = %v", x)
+		y, ok := new(Perplex).FromDiagonal(x.Diagonal())
+		return ok && y.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	p, q := big.NewInt(3), big.NewInt(2)
+	if y, ok := new(Perplex).FromDiagonal(p, q); ok {
+		t.Errorf("FromDiagonal(%v, %v) = %v, true, want false", p, q, y)
+	}
+}
+
+// Real and unreal values
+
+func TestPerplexIsReal(t *testing.T) {
+	v := func(a, b int64) *Perplex {
+		return NewPerplex(big.NewInt(a), big.NewInt(b))
+	}
+	var tests = []struct {
+		z            *Perplex
+		real, unreal bool
+	}{
+		{v(0, 0), true, false},
+		{v(3, 0), true, false},
+		{v(0, -2), false, true},
+		{v(0, 5), false, true},
+		{v(1, 1), false, false},
+	}
+	for _, test := range tests {
+		if got := test.z.IsReal(); got != test.real {
+			t.Errorf("IsReal(%v) = %v, want %v", test.z, got, test.real)
+		}
+		if got := test.z.IsUnreal(); got != test.unreal {
+			t.Errorf("IsUnreal(%v) = %v, want %v", test.z, got, test.unreal)
+		}
+	}
+}
+
+// Identities
+
+func TestPerplexIdentities(t *testing.T) {
+	f := func(x, y *Perplex) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		zero := new(Perplex).Set(y).SetZero()
+		one := new(Perplex).Set(y).SetOne()
+		if !zero.IsZero() || !zero.Equals(new(Perplex)) || one.IsZero() {
+			return false
+		}
+		if x.IsZero() != x.Equals(zero) {
+			return false
+		}
+		l := new(Perplex).Mul(x, one)
+		r := new(Perplex).Mul(one, x)
+		return new(Perplex).Add(x, zero).Equals(x) && l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPerplexIdempotents(t *testing.T) {
+	f := func(x *Perplex) bool {
+		// t.Logf("x = %v", x)
+		p, q := x.Idempotents()
+		sum := new(Perplex).Add(p, q)
+		prod := new(Perplex).Mul(p, q)
+		return sum.Equals(new(Perplex).Add(x, x)) && prod.IsZero() &&
+			p.IsZeroDiv() && q.IsZeroDiv()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	x := NewPerplex(big.NewInt(5), big.NewInt(3))
+	p, q := x.Idempotents()
+	if !p.Equals(NewPerplex(big.NewInt(8), big.NewInt(8))) || !q.Equals(NewPerplex(big.NewInt(2), big.NewInt(-2))) {
+		t.Errorf("Idempotents(%v) = %v, %v, want (8+8s), (2-2s)", x, p, q)
+	}
+}
+
+// Light-cone coordinates
+
+func TestPerplexLightCone(t *testing.T) {
+	f := func(x *Perplex) bool {
+		// t.Logf("x = %v", x)
+		u, v := x.LightCone()
+		y, ok := new(Perplex).FromLightCone(u, v)
+		return ok && y.Equals(x) && u.Mul(u, v).Cmp(x.Quad()) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	u, v := big.NewInt(1), big.NewInt(0)
+	if y, ok := new(Perplex).FromLightCone(u, v); ok {
+		t.Errorf("FromLightCone(%v, %v) = %v, true, want false", u, v, y)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+var symbSedenion = [16]string{
+	"", "e1", "e2", "e3", "e4", "e5", "e6", "e7",
+	"e8", "e9", "e10", "e11", "e12", "e13", "e14", "e15",
+}
+
+// A Sedenion represents an integral sedenion, obtained by the Cayley-Dickson
+// construction from two Cayley halves. The units of the left half are 1 and
+// e1 to e7, in the order i, j, k, m, n, p, q of Cayley, and the units of the
+// right half are e8 to e15, where e8 is the new imaginary unit.
+type Sedenion struct {
+	l, r Cayley
+}
+
+// Real returns the (integral) real part of z.
+func (z *Sedenion) Real() *big.Int {
+	return (&z.l).Real()
+}
+
+// Cartesian returns the sixteen integral Cartesian components of z.
+func (z *Sedenion) Cartesian() [16]*big.Int {
+	var v [16]*big.Int
+	v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7] = z.l.Cartesian()
+	v[8], v[9], v[10], v[11], v[12], v[13], v[14], v[15] = z.r.Cartesian()
+	return v
+}
+
+// Components returns the sixteen Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *Sedenion) Components() []*big.Int {
+	v := z.Cartesian()
+	return v[:]
+}
+
+// Halves returns the two Cayley halves of z, so that if the halves are l and
+// r, then z = l + r e8 in the Cayley-Dickson construction. The returned
+// pointers refer to z itself, like those of Cartesian.
+func (z *Sedenion) Halves() (*Cayley, *Cayley) {
+	return &z.l, &z.r
+}
+
+// String returns the string representation of a Sedenion value.
+//
+// If z corresponds to a + b e1 + ... + p e15, then the string is
+// "(a+be1+...+pe15)", similar to complex128 values.
+func (z *Sedenion) String() string {
+	v := z.Cartesian()
+	a := make([]string, 0, 33)
+	a = append(a, "(", fmt.Sprintf("%v", v[0]))
+	for i := 1; i < 16; i++ {
+		if v[i].Sign() < 0 {
+			a = append(a, fmt.Sprintf("%v", v[i]))
+		} else {
+			a = append(a, fmt.Sprintf("+%v", v[i]))
+		}
+		a = append(a, symbSedenion[i])
+	}
+	a = append(a, ")")
+	return strings.Join(a, "")
+}
+
+// Equals returns true if y and z are equal.
+func (z *Sedenion) Equals(y *Sedenion) bool {
+	return z.l.Equals(&y.l) && z.r.Equals(&y.r)
+}
+
+// Set sets z equal to y, and returns z.
+func (z *Sedenion) Set(y *Sedenion) *Sedenion {
+	z.l.Set(&y.l)
+	z.r.Set(&y.r)
+	return z
+}
+
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *Sedenion) Clone() *Sedenion {
+	return new(Sedenion).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *Sedenion) IsZero() bool {
+	return z.l.IsZero() && z.r.IsZero()
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *Sedenion) SetZero() *Sedenion {
+	z.l.SetZero()
+	z.r.SetZero()
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *Sedenion) SetOne() *Sedenion {
+	z.l.SetOne()
+	z.r.SetZero()
+	return z
+}
+
+// NewSedenion returns a pointer to the Sedenion value l + r e8 made from two
+// Cayley halves.
+func NewSedenion(l, r *Cayley) *Sedenion {
+	z := new(Sedenion)
+	z.l.Set(l)
+	z.r.Set(r)
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Sedenion) Scal(y *Sedenion, a *big.Int) *Sedenion {
+	z.l.Scal(&y.l, a)
+	z.r.Scal(&y.r, a)
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Sedenion) Neg(y *Sedenion) *Sedenion {
+	z.l.Neg(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z.
+func (z *Sedenion) Conj(y *Sedenion) *Sedenion {
+	z.l.Conj(&y.l)
+	z.r.Neg(&y.r)
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Sedenion) Add(x, y *Sedenion) *Sedenion {
+	z.l.Add(&x.l, &y.l)
+	z.r.Add(&x.r, &y.r)
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Sedenion) Sub(x, y *Sedenion) *Sedenion {
+	z.l.Sub(&x.l, &y.l)
+	z.r.Sub(&x.r, &y.r)
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// If x = a + b e8 and y = c + d e8, where a, b, c, and d are Cayley values,
+// then the product is
+// 		(Mul(a, c) - Mul(Conj(d), b)) + (Mul(d, a) + Mul(b, Conj(c))) e8
+// as in Mul for Cayley. This binary operation is noncommutative,
+// nonassociative, and not even alternative.
+func (z *Sedenion) Mul(x, y *Sedenion) *Sedenion {
+	a := new(Cayley).Set(&x.l)
+	b := new(Cayley).Set(&x.r)
+	c := new(Cayley).Set(&y.l)
+	d := new(Cayley).Set(&y.r)
+	temp := new(Cayley)
+	z.l.Sub(
+		z.l.Mul(a, c),
+		temp.Mul(temp.Conj(d), b),
+	)
+	z.r.Add(
+		z.r.Mul(d, a),
+		temp.Mul(b, temp.Conj(c)),
+	)
+	return z
+}
+
+// Commutator sets z equal to the commutator of x and y
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *Sedenion) Commutator(x, y *Sedenion) *Sedenion {
+	return z.Sub(
+		z.Mul(x, y),
+		new(Sedenion).Mul(y, x),
+	)
+}
+
+// Associator sets z equal to the associator of w, x, and y:
+// 		Mul(Mul(w, x), y) - Mul(w, Mul(x, y))
+// Then it returns z.
+func (z *Sedenion) Associator(w, x, y *Sedenion) *Sedenion {
+	temp := new(Sedenion)
+	return z.Sub(
+		z.Mul(z.Mul(w, x), y),
+		temp.Mul(w, temp.Mul(x, y)),
+	)
+}
+
+// Quad returns the quadrance of z, that is, the sum of the squares of its
+// sixteen Cartesian components. This is always non-negative, but unlike for
+// Cayley it is not multiplicative: Quad(Mul(x, y)) need not equal
+// Mul(Quad(x), Quad(y)).
+func (z *Sedenion) Quad() *big.Int {
+	return new(big.Int).Add(
+		z.l.Quad(),
+		z.r.Quad(),
+	)
+}
+
+// IsZeroDiv returns true if z is a zero divisor, that is, if there is a nonzero
+// Sedenion value y such that Mul(z, y) is zero. Unlike for Cayley, nonzero
+// zero divisors exist, even though Quad is positive definite. This is decided
+// exactly, by checking whether the 16×16 integral matrix of left
+// multiplication by z is singular.
+func (z *Sedenion) IsZeroDiv() bool {
+	rows := make([][]*big.Int, 16)
+	for i := range rows {
+		e := new(Sedenion)
+		e.Cartesian()[i].SetInt64(1)
+		v := e.Mul(z, e).Cartesian()
+		rows[i] = v[:]
+	}
+	return rank(rows) < 16
+}
+
+// Generate returns a random Sedenion value for quick.Check testing.
+func (z *Sedenion) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomSedenion := new(Sedenion)
+	for _, c := range randomSedenion.Cartesian() {
+		c.SetInt64(rand.Int63())
+	}
+	return reflect.ValueOf(randomSedenion)
+}
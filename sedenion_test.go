@@ -0,0 +1,201 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// sedenionUnit returns the Sedenion value s e_i.
+func sedenionUnit(i int, s int64) *Sedenion {
+	e := new(Sedenion)
+	e.Cartesian()[i].SetInt64(s)
+	return e
+}
+
+// Commutativity
+
+func TestSedenionAddCommutative(t *testing.T) {
+	f := func(x, y *Sedenion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Sedenion).Add(x, y)
+		r := new(Sedenion).Add(y, x)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Non-commutativity
+
+func TestSedenionMulNonCommutative(t *testing.T) {
+	f := func(x, y *Sedenion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Sedenion).Commutator(x, y)
+		return !l.IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Non-associativity
+
+func TestSedenionMulNonAssociative(t *testing.T) {
+	f := func(x, y, z *Sedenion) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l := new(Sedenion).Associator(x, y, z)
+		return !l.IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSedenionNonAlternative(t *testing.T) {
+	f := func(x, y *Sedenion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Sedenion).Associator(x, x, y)
+		return !l.IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Identity
+
+func TestSedenionMulOne(t *testing.T) {
+	f := func(x *Sedenion) bool {
+		// t.Logf("x = %v", x)
+		one := new(Sedenion).SetOne()
+		l := new(Sedenion).Mul(x, one)
+		r := new(Sedenion).Mul(one, x)
+		return l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Involutivity
+
+func TestSedenionConjInvolutive(t *testing.T) {
+	f := func(x *Sedenion) bool {
+		// t.Logf("x = %v", x)
+		l := new(Sedenion)
+		l.Conj(l.Conj(x))
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Anti-distributivity
+
+func TestSedenionMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *Sedenion) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l, r := new(Sedenion), new(Sedenion)
+		l.Conj(l.Mul(x, y))
+		r.Mul(r.Conj(y), new(Sedenion).Conj(x))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Distributivity
+
+func TestSedenionAddMulDistributive(t *testing.T) {
+	f := func(x, y, z *Sedenion) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l, r := new(Sedenion), new(Sedenion)
+		l.Mul(l.Add(x, y), z)
+		r.Add(r.Mul(x, z), new(Sedenion).Mul(y, z))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Positivity
+
+func TestSedenionQuadPositive(t *testing.T) {
+	f := func(x *Sedenion) bool {
+		// t.Logf("x = %v", x)
+		return x.Quad().Sign() > 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Composition
+
+func TestSedenionCompositionFails(t *testing.T) {
+	x := new(Sedenion).Add(sedenionUnit(1, 1), sedenionUnit(10, 1))
+	y := new(Sedenion).Add(sedenionUnit(4, 1), sedenionUnit(15, -1))
+	p := new(Sedenion).Mul(x, y)
+	l := p.Quad()
+	r := new(big.Int).Mul(x.Quad(), y.Quad())
+	if l.Cmp(r) == 0 {
+		t.Errorf("Quad(Mul(%v, %v)) = %v, want it to differ from %v", x, y, l, r)
+	}
+	if !p.IsZero() {
+		t.Errorf("Mul(%v, %v) = %v, want 0", x, y, p)
+	}
+}
+
+// Zero divisors
+
+func TestSedenionIsZeroDiv(t *testing.T) {
+	var tests = []struct {
+		x    *Sedenion
+		want bool
+	}{
+		{new(Sedenion), true},
+		{new(Sedenion).SetOne(), false},
+		{sedenionUnit(3, -2), false},
+		{sedenionUnit(15, 1), false},
+		{new(Sedenion).Add(sedenionUnit(1, 1), sedenionUnit(10, 1)), true},
+		{new(Sedenion).Add(sedenionUnit(4, 1), sedenionUnit(15, -1)), true},
+		{new(Sedenion).Add(sedenionUnit(1, 1), sedenionUnit(2, 1)), false},
+	}
+	for _, test := range tests {
+		if got := test.x.IsZeroDiv(); got != test.want {
+			t.Errorf("IsZeroDiv(%v) = %v, want %v", test.x, got, test.want)
+		}
+	}
+}
+
+func TestSedenionIsZeroDivRandom(t *testing.T) {
+	f := func(x *Sedenion) bool {
+		// t.Logf("x = %v", x)
+		return !x.IsZeroDiv()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Parsing
+
+func TestSedenionString(t *testing.T) {
+	l := NewCayley(
+		big.NewInt(1), big.NewInt(-2), big.NewInt(0), big.NewInt(0),
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0),
+	)
+	r := new(Cayley).SetOne()
+	want := "(1-2e1+0e2+0e3+0e4+0e5+0e6+0e7+1e8+0e9+0e10+0e11+0e12+0e13+0e14+0e15)"
+	if got := NewSedenion(l, r).String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -23,17 +23,31 @@ func (z *Supra) Cartesian() (*big.Int, *big.Int, *big.Int, *big.Int) {
 	return &z.l.l, &z.l.r, &z.r.l, &z.r.r
 }
 
+// Components returns the four Cartesian components of z as a slice, in the
+// order of Cartesian. The returned pointers refer to z itself.
+func (z *Supra) Components() []*big.Int {
+	a, b, c, d := z.Cartesian()
+	return []*big.Int{a, b, c, d}
+}
+
 // String returns the string representation of a Supra value.
 //
 // If z corresponds to a + bα + cβ + dγ, then the string is "(a+bα+cβ+dγ)",
 // similar to complex128 values.
 func (z *Supra) String() string {
+	return z.StringWith(symbSupra)
+}
+
+// StringWith returns the string representation of z with the units written as
+// symb, where symb[0] is the unit of the real part. The layout is the same as
+// that of String.
+func (z *Supra) StringWith(symb [4]string) string {
 	v := make([]*big.Int, 4)
 	v[0], v[1] = z.l.Cartesian()
 	v[2], v[3] = z.r.Cartesian()
 	a := make([]string, 9)
 	a[0] = "("
-	a[1] = fmt.Sprintf("%v", v[0])
+	a[1] = fmt.Sprintf("%v%s", v[0], symb[0])
 	i := 1
 	for j := 2; j < 8; j = j + 2 {
 		if v[i].Sign() == -1 {
@@ -41,13 +55,39 @@ func (z *Supra) String() string {
 		} else {
 			a[j] = fmt.Sprintf("+%v", v[i])
 		}
-		a[j+1] = symbSupra[i]
+		a[j+1] = symb[i]
 		i++
 	}
 	a[8] = ")"
 	return strings.Join(a, "")
 }
 
+// Compact returns a compact string representation of z. It is like String, but
+// zero terms are omitted and unit coefficients are collapsed, so that the
+// string is "(1)" or "(1-γ)" instead of listing every component. The zero
+// value gives "(0)".
+func (z *Supra) Compact() string {
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = z.Cartesian()
+	return "(" + compactTerms(v, symbSupra[:]) + ")"
+}
+
+// SetString sets z equal to the value of s, and returns z and a boolean
+// indicating success. The string s must be of the form "(a+bα+cβ+dγ)", where
+// omitted terms are zero. If SetString fails, the value of z is undefined but
+// the returned value is nil.
+func (z *Supra) SetString(s string) (*Supra, bool) {
+	v, ok := parseTerms(s, symbSupra[:])
+	if !ok {
+		return nil, false
+	}
+	z.l.l.Set(v[0])
+	z.l.r.Set(v[1])
+	z.r.l.Set(v[2])
+	z.r.r.Set(v[3])
+	return z, true
+}
+
 // Equals returns true if y and z are equal.
 func (z *Supra) Equals(y *Supra) bool {
 	if !z.l.Equals(&y.l) || !z.r.Equals(&y.r) {
@@ -56,6 +96,17 @@ func (z *Supra) Equals(y *Supra) bool {
 	return true
 }
 
+// IsReal returns true if all the non-real components of z are zero.
+func (z *Supra) IsReal() bool {
+	return z.l.IsReal() && z.r.Equals(new(Infra))
+}
+
+// IsUnreal returns true if the real part of z is zero and at least one of the
+// other components is not, so that z is a nonzero pure unreal value.
+func (z *Supra) IsUnreal() bool {
+	return z.l.l.Sign() == 0 && !z.IsReal()
+}
+
 // Set sets z equal to y, and returns z.
 func (z *Supra) Set(y *Supra) *Supra {
 	z.l.Set(&y.l)
@@ -63,6 +114,31 @@ func (z *Supra) Set(y *Supra) *Supra {
 	return z
 }
 
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *Supra) Clone() *Supra {
+	return new(Supra).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *Supra) IsZero() bool {
+	return z.l.IsZero() && z.r.IsZero()
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *Supra) SetZero() *Supra {
+	z.l.SetZero()
+	z.r.SetZero()
+	return z
+}
+
+// SetOne sets z equal to one, and returns z.
+func (z *Supra) SetOne() *Supra {
+	z.l.SetOne()
+	z.r.SetZero()
+	return z
+}
+
 // NewSupra returns a pointer to the Supra value a+bα+cβ+dγ.
 func NewSupra(a, b, c, d *big.Int) *Supra {
 	z := new(Supra)
@@ -73,6 +149,24 @@ func NewSupra(a, b, c, d *big.Int) *Supra {
 	return z
 }
 
+// FromInfra sets z equal to the infra number x, with zero β and γ components,
+// and returns z. The α of Infra becomes the α of Supra, but it is no longer
+// central there, since Mul(α, β) = -Mul(β, α) = γ.
+func (z *Supra) FromInfra(x *Infra) *Supra {
+	z.l.Set(x)
+	z.r.SetZero()
+	return z
+}
+
+// ToInfra returns a pointer to a new Infra value holding the real and α
+// components of z, and true if the β and γ components of z are zero. Otherwise
+// the projection is still returned, but with false. Every product involving β
+// or γ has zero real and α components, so the projection of Mul(x, y) is
+// always the product of the projections of x and y.
+func (z *Supra) ToInfra() (*Infra, bool) {
+	return new(Infra).Set(&z.l), z.r.IsZero()
+}
+
 // Scal sets z equal to y scaled by a, and returns z.
 func (z *Supra) Scal(y *Supra, a *big.Int) *Supra {
 	z.l.Scal(&y.l, a)
@@ -108,6 +202,16 @@ func (z *Supra) Sub(x, y *Supra) *Supra {
 	return z
 }
 
+// Sum sets z equal to the sum of xs, and returns z. If xs is empty, then z is
+// zero.
+func (z *Supra) Sum(xs ...*Supra) *Supra {
+	s := new(Supra)
+	for _, x := range xs {
+		s.Add(s, x)
+	}
+	return z.Set(s)
+}
+
 // Mul sets z equal to the product of x and y, and returns z.
 //
 // The multiplication rules are:
@@ -150,6 +254,23 @@ func (z *Supra) IsZeroDiv() bool {
 	return z.l.IsZeroDiv()
 }
 
+// NilpotencyIndex returns the smallest positive n such that z raised to the
+// nth power vanishes, or 0 if there is no such n. The zero divisors are exactly
+// the nilpotent elements, and if z = bα+cβ+dγ, then
+// 		Mul(z, z) = Mul(b, c)(Mul(α, β) + Mul(β, α)) = 0
+// so the index is 1 for zero, 2 for any other zero divisor, and 0 otherwise.
+// Note that a product of two distinct nilpotents need not vanish, since
+// Mul(α, β) = γ, but any product of three nilpotents does.
+func (z *Supra) NilpotencyIndex() int {
+	switch {
+	case z.IsZero():
+		return 1
+	case z.IsZeroDiv():
+		return 2
+	}
+	return 0
+}
+
 // Quo sets z equal to the quotient of x and y, and returns z.
 func (z *Supra) Quo(x, y *Supra) *Supra {
 	if y.IsZeroDiv() {
@@ -165,6 +286,50 @@ func (z *Supra) Quo(x, y *Supra) *Supra {
 	return z
 }
 
+// Inv sets z equal to the inverse of y, and returns nil. The inverse of y is
+// 		Conj(y) / Quad(y)
+// which is integral only if the real part of y is ±1. If y is a zero divisor,
+// then Inv returns ErrZeroDivisor; if the inverse is not integral, then Inv
+// returns ErrNotIntegral. In both cases z is not modified.
+func (z *Supra) Inv(y *Supra) error {
+	if y.IsZeroDiv() {
+		return ErrZeroDivisor
+	}
+	c := new(Supra).Conj(y)
+	v := make([]*big.Int, 4)
+	v[0], v[1], v[2], v[3] = c.Cartesian()
+	if !quoExact(v, y.Quad()) {
+		return ErrNotIntegral
+	}
+	z.Set(c)
+	return nil
+}
+
+// Pow sets z equal to x raised to the nth power, and returns z. Binary
+// exponentiation is used, which is well-defined since multiplication is
+// associative. If n is zero, then z is one. If n is negative, then z is the
+// quotient of one and x raised to the -n power, as given by Quo, so Pow panics
+// if x is a zero divisor.
+func (z *Supra) Pow(x *Supra, n int) *Supra {
+	if n < 0 {
+		p := new(Supra).Pow(x, -n)
+		zero := new(big.Int)
+		return z.Quo(NewSupra(big.NewInt(1), zero, zero, zero), p)
+	}
+	p := new(Supra).Set(x)
+	z.Set(new(Supra))
+	z.l.l.SetInt64(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			z.Mul(z, p)
+		}
+		if n > 1 {
+			p.Mul(p, p)
+		}
+	}
+	return z
+}
+
 // Generate returns a random Supra value for quick.Check testing.
 func (z *Supra) Generate(rand *rand.Rand, size int) reflect.Value {
 	randomSupra := &Supra{
@@ -290,3 +290,285 @@ func TestSupraComposition(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// Parsing
+
+func TestSupraStringSetString(t *testing.T) {
+	f := func(x *Supra) bool {
+		// t.Logf("x = %v", x)
+		l, ok := new(Supra).SetString(x.String())
+		return ok && l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraSetString(t *testing.T) {
+	var tests = []struct {
+		s    string
+		want *Supra
+	}{
+		{"(1+2α+3β+4γ)", NewSupra(big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4))},
+		{"(-1-2α-3β-4γ)", NewSupra(big.NewInt(-1), big.NewInt(-2), big.NewInt(-3), big.NewInt(-4))},
+		{"(5)", NewSupra(big.NewInt(5), big.NewInt(0), big.NewInt(0), big.NewInt(0))},
+		{"(3β)", NewSupra(big.NewInt(0), big.NewInt(0), big.NewInt(3), big.NewInt(0))},
+		{"(γ-α)", NewSupra(big.NewInt(0), big.NewInt(-1), big.NewInt(0), big.NewInt(1))},
+	}
+	for _, test := range tests {
+		if got, ok := new(Supra).SetString(test.s); !ok || !got.Equals(test.want) {
+			t.Errorf("SetString(%q) = %v, %v, want %v", test.s, got, ok, test.want)
+		}
+	}
+}
+
+func TestSupraSetStringMalformed(t *testing.T) {
+	for _, s := range []string{
+		"", "()", "1+2α", "(1+2α", "(1+2x)", "(1+2α+3α)", "(1++2α)", "(1+2 α)",
+		"(+)", "(α2)",
+	} {
+		if _, ok := new(Supra).SetString(s); ok {
+			t.Errorf("SetString(%q) succeeded, want failure", s)
+		}
+	}
+}
+
+// Powers
+
+func TestSupraPowNaive(t *testing.T) {
+	f := func(x *Supra, n uint8) bool {
+		// t.Logf("x = %v, n = %v", x, n)
+		n = n % 16
+		zero := new(big.Int)
+		r := NewSupra(big.NewInt(1), zero, zero, zero)
+		for i := uint8(0); i < n; i++ {
+			r.Mul(r, x)
+		}
+		return new(Supra).Pow(x, int(n)).Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraPowNegative(t *testing.T) {
+	one, zero := big.NewInt(1), new(big.Int)
+	x := NewSupra(one, big.NewInt(2), big.NewInt(3), big.NewInt(4))
+	l := new(Supra).Pow(x, -2)
+	r := new(Supra).Mul(l, new(Supra).Pow(x, 2))
+	if want := NewSupra(one, zero, zero, zero); !r.Equals(want) {
+		t.Errorf("Mul(Pow(%v, -2), Pow(%v, 2)) = %v, want %v", x, x, r, want)
+	}
+	y := NewSupra(zero, one, one, one)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Pow(%v, -1) did not panic", y)
+		}
+	}()
+	new(Supra).Pow(y, -1)
+}
+
+// Sums
+
+func TestSupraSum(t *testing.T) {
+	f := func(x, y, w *Supra) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		l := new(Supra).Sum(x, y, w)
+		r := new(Supra).Add(new(Supra).Add(x, y), w)
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if z := new(Supra).Sum(); !z.Equals(new(Supra)) {
+		t.Errorf("Sum() = %v, want zero", z)
+	}
+}
+
+// Inverses
+
+func TestSupraInv(t *testing.T) {
+	s := func(a, b, c, d int64) *Supra {
+		return NewSupra(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		y, want *Supra
+		err     error
+	}{
+		{s(1, 0, 0, 0), s(1, 0, 0, 0), nil},
+		{s(1, 2, 3, 4), s(1, -2, -3, -4), nil},
+		{s(-1, 0, 5, 0), s(-1, 0, -5, 0), nil},
+		{s(0, 0, 0, 0), nil, ErrZeroDivisor},
+		{s(0, 1, 1, 1), nil, ErrZeroDivisor},
+		{s(2, 0, 0, 0), nil, ErrNotIntegral},
+		{s(3, 0, 0, 9), nil, ErrNotIntegral},
+	}
+	for _, test := range tests {
+		z := s(7, 7, 7, 7)
+		err := z.Inv(test.y)
+		if err != test.err {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, err, test.err)
+			continue
+		}
+		if err != nil {
+			if !z.Equals(s(7, 7, 7, 7)) {
+				t.Errorf("Inv(%v) modified z to %v", test.y, z)
+			}
+			continue
+		}
+		if !z.Equals(test.want) {
+			t.Errorf("Inv(%v) = %v, want %v", test.y, z, test.want)
+		}
+	}
+}
+
+// Real and unreal values
+
+func TestSupraIsReal(t *testing.T) {
+	v := func(a, b, c, d int64) *Supra {
+		return NewSupra(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		z            *Supra
+		real, unreal bool
+	}{
+		{v(0, 0, 0, 0), true, false},
+		{v(3, 0, 0, 0), true, false},
+		{v(0, 0, 0, -2), false, true},
+		{v(0, 5, 0, 0), false, true},
+		{v(1, 0, 0, 1), false, false},
+	}
+	for _, test := range tests {
+		if got := test.z.IsReal(); got != test.real {
+			t.Errorf("IsReal(%v) = %v, want %v", test.z, got, test.real)
+		}
+		if got := test.z.IsUnreal(); got != test.unreal {
+			t.Errorf("IsUnreal(%v) = %v, want %v", test.z, got, test.unreal)
+		}
+	}
+}
+
+// Identities
+
+func TestSupraIdentities(t *testing.T) {
+	f := func(x, y *Supra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		zero := new(Supra).Set(y).SetZero()
+		one := new(Supra).Set(y).SetOne()
+		if !zero.IsZero() || !zero.Equals(new(Supra)) || one.IsZero() {
+			return false
+		}
+		if x.IsZero() != x.Equals(zero) {
+			return false
+		}
+		l := new(Supra).Mul(x, one)
+		r := new(Supra).Mul(one, x)
+		return new(Supra).Add(x, zero).Equals(x) && l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Nilpotents
+
+func TestSupraNilpotencyIndex(t *testing.T) {
+	s := func(a, b, c, d int64) *Supra {
+		return NewSupra(big.NewInt(a), big.NewInt(b), big.NewInt(c), big.NewInt(d))
+	}
+	var tests = []struct {
+		x    *Supra
+		want int
+	}{
+		{s(0, 0, 0, 0), 1},
+		{s(0, 1, 0, 0), 2},
+		{s(0, 0, 1, 0), 2},
+		{s(0, 0, 0, 1), 2},
+		{s(0, 1, 1, 0), 2},
+		{s(0, 3, -5, 7), 2},
+		{s(1, 0, 0, 0), 0},
+		{s(-2, 1, 1, 1), 0},
+	}
+	for _, test := range tests {
+		if got := test.x.NilpotencyIndex(); got != test.want {
+			t.Errorf("NilpotencyIndex(%v) = %d, want %d", test.x, got, test.want)
+		}
+	}
+}
+
+func TestSupraNilpotencyIndexPow(t *testing.T) {
+	f := func(x *Supra) bool {
+		// t.Logf("x = %v", x)
+		n := x.NilpotencyIndex()
+		if n == 0 {
+			return !new(Supra).Pow(x, 2).IsZero()
+		}
+		return new(Supra).Pow(x, n).IsZero() &&
+			(n == 1 || !new(Supra).Pow(x, n-1).IsZero())
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraNilpotentProducts(t *testing.T) {
+	zero := new(big.Int)
+	f := func(x, y, w *Supra) bool {
+		// t.Logf("x = %v, y = %v, w = %v", x, y, w)
+		x.l.l.Set(zero)
+		y.l.l.Set(zero)
+		w.l.l.Set(zero)
+		p := new(Supra).Mul(x, y)
+		if p.NilpotencyIndex() == 0 {
+			return false
+		}
+		return p.Mul(p, w).IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	a := NewSupra(zero, big.NewInt(1), zero, zero)
+	b := NewSupra(zero, zero, big.NewInt(1), zero)
+	if new(Supra).Mul(a, b).IsZero() {
+		t.Error("Mul(α, β) = 0, want γ")
+	}
+}
+
+// Embeddings
+
+func TestSupraFromInfra(t *testing.T) {
+	zero, one := new(big.Int), big.NewInt(1)
+	beta := NewSupra(zero, zero, one, zero)
+	f := func(x *Infra) bool {
+		// t.Logf("x = %v", x)
+		a := new(Supra).FromInfra(x)
+		if z, ok := a.ToInfra(); !ok || !z.Equals(x) {
+			return false
+		}
+		if a.Quad().Cmp(x.Quad()) != 0 {
+			return false
+		}
+		_, b := x.Cartesian()
+		twoB := new(big.Int).Lsh(b, 1)
+		return new(Supra).Commutator(a, beta).Equals(NewSupra(zero, zero, zero, twoB))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupraToInfra(t *testing.T) {
+	f := func(x, y *Supra) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		a, ok := x.ToInfra()
+		if ok != x.r.IsZero() {
+			return false
+		}
+		b, _ := y.ToInfra()
+		prod, _ := new(Supra).Mul(x, y).ToInfra()
+		return prod.Equals(new(Infra).Mul(a, b))
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
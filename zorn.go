@@ -0,0 +1,280 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"reflect"
+)
+
+// A Zorn represents an integral split octonion, written as a Zorn
+// vector-matrix
+// 		[a  u]
+// 		[v  b]
+// where a and b are scalars and u and v are 3-vectors.
+type Zorn struct {
+	a, b big.Int
+	u, v [3]big.Int
+}
+
+// Parts returns the scalars a and b and the vectors u and v of z. The
+// returned pointers refer to z itself.
+func (z *Zorn) Parts() (*big.Int, [3]*big.Int, [3]*big.Int, *big.Int) {
+	return &z.a,
+		[3]*big.Int{&z.u[0], &z.u[1], &z.u[2]},
+		[3]*big.Int{&z.v[0], &z.v[1], &z.v[2]},
+		&z.b
+}
+
+// Components returns the eight integral components of z as a slice, in the
+// order a, u0, u1, u2, v0, v1, v2, b. The returned pointers refer to z itself.
+func (z *Zorn) Components() []*big.Int {
+	return []*big.Int{&z.a, &z.u[0], &z.u[1], &z.u[2], &z.v[0], &z.v[1], &z.v[2], &z.b}
+}
+
+// String returns the string representation of a Zorn value.
+//
+// If z corresponds to the vector-matrix with scalars a and b and vectors u and
+// v, then the string is "[a (u0 u1 u2); (v0 v1 v2) b]".
+func (z *Zorn) String() string {
+	return fmt.Sprintf("[%v (%v %v %v); (%v %v %v) %v]",
+		&z.a, &z.u[0], &z.u[1], &z.u[2], &z.v[0], &z.v[1], &z.v[2], &z.b)
+}
+
+// Equals returns true if y and z are equal.
+func (z *Zorn) Equals(y *Zorn) bool {
+	if z.a.Cmp(&y.a) != 0 || z.b.Cmp(&y.b) != 0 {
+		return false
+	}
+	for i := range z.u {
+		if z.u[i].Cmp(&y.u[i]) != 0 || z.v[i].Cmp(&y.v[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Set sets z equal to y, and returns z.
+func (z *Zorn) Set(y *Zorn) *Zorn {
+	z.a.Set(&y.a)
+	z.b.Set(&y.b)
+	for i := range z.u {
+		z.u[i].Set(&y.u[i])
+		z.v[i].Set(&y.v[i])
+	}
+	return z
+}
+
+// Clone returns a pointer to a new copy of z. The components of the copy are
+// allocated independently, so modifying it does not affect z.
+func (z *Zorn) Clone() *Zorn {
+	return new(Zorn).Set(z)
+}
+
+// IsZero returns true if z is zero.
+func (z *Zorn) IsZero() bool {
+	return z.Equals(new(Zorn))
+}
+
+// SetZero sets z equal to zero, and returns z.
+func (z *Zorn) SetZero() *Zorn {
+	return z.Set(new(Zorn))
+}
+
+// SetOne sets z equal to one, the vector-matrix with a = b = 1 and u = v = 0,
+// and returns z.
+func (z *Zorn) SetOne() *Zorn {
+	z.SetZero()
+	z.a.SetInt64(1)
+	z.b.SetInt64(1)
+	return z
+}
+
+// NewZorn returns a pointer to the Zorn value with scalars a and b and vectors
+// u and v.
+func NewZorn(a *big.Int, u, v [3]*big.Int, b *big.Int) *Zorn {
+	z := new(Zorn)
+	z.a.Set(a)
+	z.b.Set(b)
+	for i := range z.u {
+		z.u[i].Set(u[i])
+		z.v[i].Set(v[i])
+	}
+	return z
+}
+
+// Scal sets z equal to y scaled by a, and returns z.
+func (z *Zorn) Scal(y *Zorn, a *big.Int) *Zorn {
+	z.a.Mul(&y.a, a)
+	z.b.Mul(&y.b, a)
+	for i := range z.u {
+		z.u[i].Mul(&y.u[i], a)
+		z.v[i].Mul(&y.v[i], a)
+	}
+	return z
+}
+
+// Neg sets z equal to the negative of y, and returns z.
+func (z *Zorn) Neg(y *Zorn) *Zorn {
+	z.a.Neg(&y.a)
+	z.b.Neg(&y.b)
+	for i := range z.u {
+		z.u[i].Neg(&y.u[i])
+		z.v[i].Neg(&y.v[i])
+	}
+	return z
+}
+
+// Conj sets z equal to the conjugate of y, and returns z. If y has scalars a
+// and b and vectors u and v, then the conjugate is
+// 		[ b  -u]
+// 		[-v   a]
+func (z *Zorn) Conj(y *Zorn) *Zorn {
+	a := new(big.Int).Set(&y.a)
+	z.a.Set(&y.b)
+	z.b.Set(a)
+	for i := range z.u {
+		z.u[i].Neg(&y.u[i])
+		z.v[i].Neg(&y.v[i])
+	}
+	return z
+}
+
+// Add sets z equal to x+y, and returns z.
+func (z *Zorn) Add(x, y *Zorn) *Zorn {
+	z.a.Add(&x.a, &y.a)
+	z.b.Add(&x.b, &y.b)
+	for i := range z.u {
+		z.u[i].Add(&x.u[i], &y.u[i])
+		z.v[i].Add(&x.v[i], &y.v[i])
+	}
+	return z
+}
+
+// Sub sets z equal to x-y, and returns z.
+func (z *Zorn) Sub(x, y *Zorn) *Zorn {
+	z.a.Sub(&x.a, &y.a)
+	z.b.Sub(&x.b, &y.b)
+	for i := range z.u {
+		z.u[i].Sub(&x.u[i], &y.u[i])
+		z.v[i].Sub(&x.v[i], &y.v[i])
+	}
+	return z
+}
+
+// Mul sets z equal to the product of x and y, and returns z.
+//
+// If x has scalars a and b and vectors u and v, and y has scalars c and d and
+// vectors s and t, then the product has scalars
+// 		Mul(a, c) + Dot(u, t) and Mul(b, d) + Dot(v, s)
+// and vectors
+// 		Mul(a, s) + Mul(d, u) - Cross(v, t)
+// 		Mul(c, v) + Mul(b, t) + Cross(u, s)
+// where Dot and Cross are the usual products of 3-vectors.
+// This is Zorn's vector-matrix multiplication. It is noncommutative and
+// nonassociative, but it is alternative.
+func (z *Zorn) Mul(x, y *Zorn) *Zorn {
+	var a, b big.Int
+	var u, v [3]big.Int
+	temp := new(big.Int)
+	a.Mul(&x.a, &y.a)
+	a.Add(&a, dot3(&x.u, &y.v))
+	b.Mul(&x.b, &y.b)
+	b.Add(&b, dot3(&x.v, &y.u))
+	xu, xv := cross3(&x.u, &y.u), cross3(&x.v, &y.v)
+	for i := range u {
+		u[i].Mul(&x.a, &y.u[i])
+		u[i].Add(&u[i], temp.Mul(&y.b, &x.u[i]))
+		u[i].Sub(&u[i], xv[i])
+		v[i].Mul(&y.a, &x.v[i])
+		v[i].Add(&v[i], temp.Mul(&x.b, &y.v[i]))
+		v[i].Add(&v[i], xu[i])
+	}
+	z.a.Set(&a)
+	z.b.Set(&b)
+	for i := range z.u {
+		z.u[i].Set(&u[i])
+		z.v[i].Set(&v[i])
+	}
+	return z
+}
+
+// dot3 returns the dot product of the 3-vectors x and y.
+func dot3(x, y *[3]big.Int) *big.Int {
+	dot := new(big.Int)
+	temp := new(big.Int)
+	for i := range x {
+		dot.Add(dot, temp.Mul(&x[i], &y[i]))
+	}
+	return dot
+}
+
+// cross3 returns the cross product of the 3-vectors x and y.
+func cross3(x, y *[3]big.Int) [3]*big.Int {
+	var c [3]*big.Int
+	for i := range c {
+		j, k := (i+1)%3, (i+2)%3
+		c[i] = new(big.Int).Mul(&x[j], &y[k])
+		c[i].Sub(c[i], new(big.Int).Mul(&x[k], &y[j]))
+	}
+	return c
+}
+
+// Commutator sets z equal to the commutator of x and y
+// 		Mul(x, y) - Mul(y, x)
+// Then it returns z.
+func (z *Zorn) Commutator(x, y *Zorn) *Zorn {
+	return z.Sub(
+		z.Mul(x, y),
+		new(Zorn).Mul(y, x),
+	)
+}
+
+// Associator sets z equal to the associator of w, x, and y:
+// 		Mul(Mul(w, x), y) - Mul(w, Mul(x, y))
+// Then it returns z.
+func (z *Zorn) Associator(w, x, y *Zorn) *Zorn {
+	temp := new(Zorn)
+	return z.Sub(
+		z.Mul(z.Mul(w, x), y),
+		temp.Mul(w, temp.Mul(x, y)),
+	)
+}
+
+// Quad returns the quadrance of z. If z has scalars a and b and vectors u and
+// v, then the quadrance is the determinant
+// 		Mul(a, b) - Dot(u, v)
+// This can be positive, negative, or zero, and Mul(z, Conj(z)) is Quad(z)
+// times one. It is multiplicative: Quad(Mul(x, y)) = Mul(Quad(x), Quad(y)).
+func (z *Zorn) Quad() *big.Int {
+	quad := new(big.Int).Mul(&z.a, &z.b)
+	return quad.Sub(quad, dot3(&z.u, &z.v))
+}
+
+// Trace returns the trace of z, that is, a+b. Together with Quad, it gives the
+// coefficients of the minimal polynomial
+// 		Mul(z, z) - Mul(Trace(z), z) + Quad(z) = 0
+func (z *Zorn) Trace() *big.Int {
+	return new(big.Int).Add(&z.a, &z.b)
+}
+
+// IsZeroDiv returns true if z is a zero divisor. This is equivalent to Quad(z)
+// being zero.
+func (z *Zorn) IsZeroDiv() bool {
+	return z.Quad().Sign() == 0
+}
+
+// Generate returns a random Zorn value for quick.Check testing.
+func (z *Zorn) Generate(rand *rand.Rand, size int) reflect.Value {
+	randomZorn := new(Zorn)
+	randomZorn.a.SetInt64(rand.Int63())
+	randomZorn.b.SetInt64(rand.Int63())
+	for i := range randomZorn.u {
+		randomZorn.u[i].SetInt64(rand.Int63())
+		randomZorn.v[i].SetInt64(rand.Int63())
+	}
+	return reflect.ValueOf(randomZorn)
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2016 Melvin Eloy Irizarry-Gelpí
+// Licenced under the MIT License.
+
+package integral
+
+import (
+	"math/big"
+	"testing"
+	"testing/quick"
+)
+
+// zornOf returns a pointer to the Zorn value with scalars a and b and vectors
+// u and v.
+func zornOf(a int64, u, v [3]int64, b int64) *Zorn {
+	var bu, bv [3]*big.Int
+	for i := range u {
+		bu[i], bv[i] = big.NewInt(u[i]), big.NewInt(v[i])
+	}
+	return NewZorn(big.NewInt(a), bu, bv, big.NewInt(b))
+}
+
+// Non-commutativity
+
+func TestZornMulNonCommutative(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return !new(Zorn).Commutator(x, y).IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Non-associativity
+
+func TestZornMulNonAssociative(t *testing.T) {
+	f := func(x, y, z *Zorn) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		return !new(Zorn).Associator(x, y, z).IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Alternativity
+
+func TestZornLeftAlternative(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return new(Zorn).Associator(x, x, y).IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornRightAlternative(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		return new(Zorn).Associator(x, y, y).IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Identity
+
+func TestZornMulOne(t *testing.T) {
+	f := func(x *Zorn) bool {
+		// t.Logf("x = %v", x)
+		one := new(Zorn).SetOne()
+		l := new(Zorn).Mul(x, one)
+		r := new(Zorn).Mul(one, x)
+		return l.Equals(x) && r.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Involutivity
+
+func TestZornConjInvolutive(t *testing.T) {
+	f := func(x *Zorn) bool {
+		// t.Logf("x = %v", x)
+		l := new(Zorn)
+		l.Conj(l.Conj(x))
+		return l.Equals(x)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Anti-distributivity
+
+func TestZornMulConjAntiDistributive(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l, r := new(Zorn), new(Zorn)
+		l.Conj(l.Mul(x, y))
+		r.Mul(r.Conj(y), new(Zorn).Conj(x))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Distributivity
+
+func TestZornAddMulDistributive(t *testing.T) {
+	f := func(x, y, z *Zorn) bool {
+		// t.Logf("x = %v, y = %v, z = %v", x, y, z)
+		l, r := new(Zorn), new(Zorn)
+		l.Mul(l.Add(x, y), z)
+		r.Add(r.Mul(x, z), new(Zorn).Mul(y, z))
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Composition
+
+func TestZornComposition(t *testing.T) {
+	f := func(x, y *Zorn) bool {
+		// t.Logf("x = %v, y = %v", x, y)
+		l := new(Zorn).Mul(x, y).Quad()
+		r := new(big.Int).Mul(x.Quad(), y.Quad())
+		return l.Cmp(r) == 0
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornMulConjQuad(t *testing.T) {
+	f := func(x *Zorn) bool {
+		// t.Logf("x = %v", x)
+		l := new(Zorn).Mul(x, new(Zorn).Conj(x))
+		r := new(Zorn).SetOne()
+		r.Scal(r, x.Quad())
+		return l.Equals(r)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestZornMinimalPolynomial(t *testing.T) {
+	f := func(x *Zorn) bool {
+		// t.Logf("x = %v", x)
+		l := new(Zorn).Mul(x, x)
+		l.Sub(l, new(Zorn).Scal(x, x.Trace()))
+		one := new(Zorn).SetOne()
+		return l.Add(l, one.Scal(one, x.Quad())).IsZero()
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// Zero divisors
+
+func TestZornIsZeroDiv(t *testing.T) {
+	var tests = []struct {
+		x    *Zorn
+		quad int64
+	}{
+		{zornOf(1, [3]int64{}, [3]int64{}, 0), 0},
+		{zornOf(0, [3]int64{1, 0, 0}, [3]int64{}, 0), 0},
+		{zornOf(1, [3]int64{1, 0, 0}, [3]int64{1, 0, 0}, 1), 0},
+		{zornOf(1, [3]int64{}, [3]int64{}, 1), 1},
+		{zornOf(0, [3]int64{1, 0, 0}, [3]int64{1, 0, 0}, 0), -1},
+		{zornOf(2, [3]int64{1, 2, 3}, [3]int64{0, 1, 1}, 5), 5},
+	}
+	for _, test := range tests {
+		if got := test.x.Quad(); got.Cmp(big.NewInt(test.quad)) != 0 {
+			t.Errorf("Quad(%v) = %v, want %d", test.x, got, test.quad)
+		}
+		if got := test.x.IsZeroDiv(); got != (test.quad == 0) {
+			t.Errorf("IsZeroDiv(%v) = %v, want %v", test.x, got, test.quad == 0)
+		}
+	}
+	x := zornOf(1, [3]int64{}, [3]int64{}, 0)
+	y := zornOf(0, [3]int64{}, [3]int64{}, 1)
+	if p := new(Zorn).Mul(x, y); !p.IsZero() {
+		t.Errorf("Mul(%v, %v) = %v, want 0", x, y, p)
+	}
+}
+
+// Parsing
+
+func TestZornString(t *testing.T) {
+	x := zornOf(1, [3]int64{2, -3, 0}, [3]int64{0, 0, 4}, -5)
+	want := "[1 (2 -3 0); (0 0 4) -5]"
+	if got := x.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}